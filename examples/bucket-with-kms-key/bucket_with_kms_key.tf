@@ -25,6 +25,15 @@ resource "huaweicloud_s3_bucket" "bucket1" {
   region = "${var.region}"
   bucket = "test-bucket-with-kms-key-1234567890000"
   acl    = "public-read-write"
+
+  server_side_encryption_configuration {
+    rule {
+      apply_server_side_encryption_by_default {
+        kms_master_key_id = "${huaweicloud_kms_key_v1.kms_key1.id}"
+        sse_algorithm     = "aws:kms"
+      }
+    }
+  }
 }
 
 resource "huaweicloud_s3_bucket_object" "object1" {
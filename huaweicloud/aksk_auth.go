@@ -0,0 +1,327 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	huaweisdk "github.com/huaweicloud/golangsdk/openstack"
+	"github.com/huaweicloud/golangsdk/openstack/identity/v3/projects"
+)
+
+const (
+	akskSignAlgorithm  = "SDK-HMAC-SHA256"
+	akskHeaderSdkDate  = "X-Sdk-Date"
+	akskHeaderProject  = "X-Project-Id"
+	akskHeaderToken    = "X-Security-Token"
+	akskSdkDateFormat  = "20060102T150405Z"
+	akskHeaderAuthName = "Authorization"
+)
+
+// akskServiceEndpoints maps the service catalog types requested by the
+// service clients to the endpoint templates used when no catalog is
+// available, i.e. when authenticating with an AK/SK pair only. The host is
+// built from the service name, the region and the cloud domain; the path must
+// match what the catalog would have returned for the same service type.
+var akskServiceEndpoints = map[string]struct {
+	Service string
+	Path    string
+}{
+	"compute":  {"ecs", "v2/{project_id}/"},
+	"network":  {"vpc", ""},
+	"volumev2": {"evs", "v2/{project_id}/"},
+	"image":    {"ims", ""},
+	"dns":      {"dns", ""},
+	"ces":      {"ces", "V1.0/"},
+	"as":       {"as", "autoscaling-api/v1/"},
+}
+
+// AKSKRoundTripper satisfies the http.RoundTripper interface and signs every
+// request with the SDK-HMAC-SHA256 algorithm before handing it to Rt.
+// SecurityToken is only set for temporary credentials.
+type AKSKRoundTripper struct {
+	Rt            http.RoundTripper
+	AccessKey     string
+	SecretKey     string
+	SecurityToken string
+	ProjectID     string
+}
+
+// RoundTrip signs a copy of the request and performs the round-trip.
+func (art *AKSKRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	req := new(http.Request)
+	*req = *request
+	req.Header = make(http.Header, len(request.Header)+3)
+	for k, v := range request.Header {
+		req.Header[k] = append([]string(nil), v...)
+	}
+
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	if art.ProjectID != "" && req.Header.Get(akskHeaderProject) == "" {
+		req.Header.Set(akskHeaderProject, art.ProjectID)
+	}
+	if art.SecurityToken != "" {
+		req.Header.Set(akskHeaderToken, art.SecurityToken)
+	}
+
+	signAKSKRequest(req, body, art.AccessKey, art.SecretKey, time.Now().UTC())
+
+	return art.Rt.RoundTrip(req)
+}
+
+// signAKSKRequest adds the X-Sdk-Date and Authorization headers to req. Every
+// header already set on the request, plus host, is signed.
+func signAKSKRequest(req *http.Request, body []byte, accessKey, secretKey string, t time.Time) {
+	req.Header.Del(akskHeaderAuthName)
+	req.Header.Set(akskHeaderSdkDate, t.Format(akskSdkDateFormat))
+
+	signedHeaders := akskSignedHeaders(req)
+	canonicalRequest := akskCanonicalRequest(req, body, signedHeaders)
+	stringToSign := akskStringToSign(canonicalRequest, req.Header.Get(akskHeaderSdkDate))
+	signature := akskSignature(secretKey, stringToSign)
+
+	req.Header.Set(akskHeaderAuthName, fmt.Sprintf("%s Access=%s, SignedHeaders=%s, Signature=%s",
+		akskSignAlgorithm, accessKey, strings.Join(signedHeaders, ";"), signature))
+}
+
+func akskSignedHeaders(req *http.Request) []string {
+	signedHeaders := []string{"host"}
+	for k := range req.Header {
+		k = strings.ToLower(k)
+		if k == "host" || k == strings.ToLower(akskHeaderAuthName) {
+			continue
+		}
+		signedHeaders = append(signedHeaders, k)
+	}
+	sort.Strings(signedHeaders)
+
+	return signedHeaders
+}
+
+// akskCanonicalRequest builds the canonical form of req which is hashed into
+// the string to sign:
+//
+//	Method\nCanonicalURI\nCanonicalQueryString\nCanonicalHeaders\nSignedHeaders\nHexEncode(Hash(Body))
+func akskCanonicalRequest(req *http.Request, body []byte, signedHeaders []string) string {
+	return fmt.Sprintf("%s\n%s\n%s\n%s\n%s\n%s",
+		req.Method,
+		akskCanonicalURI(req.URL),
+		akskCanonicalQueryString(req.URL),
+		akskCanonicalHeaders(req, signedHeaders),
+		strings.Join(signedHeaders, ";"),
+		akskHexHash(body))
+}
+
+// akskCanonicalURI escapes every segment of the path and always ends it with
+// a slash.
+func akskCanonicalURI(u *url.URL) string {
+	segments := strings.Split(u.Path, "/")
+	for i, v := range segments {
+		segments[i] = akskEscape(v)
+	}
+
+	uri := strings.Join(segments, "/")
+	if !strings.HasSuffix(uri, "/") {
+		uri = uri + "/"
+	}
+
+	return uri
+}
+
+// akskCanonicalQueryString sorts the query parameters by key, then by value.
+func akskCanonicalQueryString(u *url.URL) string {
+	query := u.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var params []string
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			params = append(params, akskEscape(k)+"="+akskEscape(v))
+		}
+	}
+
+	return strings.Join(params, "&")
+}
+
+func akskCanonicalHeaders(req *http.Request, signedHeaders []string) string {
+	headers := make([]string, 0, len(signedHeaders))
+	for _, k := range signedHeaders {
+		var value string
+		if k == "host" {
+			value = req.Host
+			if value == "" {
+				value = req.URL.Host
+			}
+		} else {
+			value = strings.Join(req.Header[textproto.CanonicalMIMEHeaderKey(k)], ",")
+		}
+		headers = append(headers, k+":"+strings.TrimSpace(value))
+	}
+
+	return strings.Join(headers, "\n") + "\n"
+}
+
+func akskStringToSign(canonicalRequest, sdkDate string) string {
+	return fmt.Sprintf("%s\n%s\n%s", akskSignAlgorithm, sdkDate, akskHexHash([]byte(canonicalRequest)))
+}
+
+func akskSignature(secretKey, stringToSign string) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write([]byte(stringToSign))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func akskHexHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// akskEscape percent-encodes everything except the unreserved characters of
+// RFC 3986.
+func akskEscape(s string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			buf.WriteByte(c)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", c)
+		}
+	}
+
+	return buf.String()
+}
+
+// akskCloudDomain derives the cloud domain, e.g. myhuaweicloud.com, from the
+// host of the identity endpoint such as iam.cn-north-1.myhuaweicloud.com.
+func akskCloudDomain(identityEndpoint, region string) (string, error) {
+	u, err := url.Parse(identityEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing auth_url %q: %s", identityEndpoint, err)
+	}
+
+	host := u.Hostname()
+	parts := strings.SplitN(host, ".", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("Unable to determine the cloud domain from auth_url %q", identityEndpoint)
+	}
+
+	domain := parts[1]
+	if region != "" {
+		domain = strings.TrimPrefix(domain, region+".")
+	}
+
+	return domain, nil
+}
+
+// akskEndpointURL locates a service endpoint without a service catalog.
+func akskEndpointURL(opts golangsdk.EndpointOpts, domain, projectID string) (string, error) {
+	endpoint, ok := akskServiceEndpoints[opts.Type]
+	if !ok {
+		return "", fmt.Errorf("Service type %q is not supported with AK/SK authentication", opts.Type)
+	}
+
+	host := endpoint.Service + "." + domain
+	if opts.Region != "" {
+		host = endpoint.Service + "." + opts.Region + "." + domain
+	}
+
+	path := strings.Replace(endpoint.Path, "{project_id}", projectID, -1)
+
+	return "https://" + host + "/" + path, nil
+}
+
+// akskProjectID looks up the ID of the named project through IAM. The
+// request is signed like any other, so no token is required.
+func akskProjectID(client *golangsdk.ProviderClient, name string) (string, error) {
+	identityClient, err := huaweisdk.NewIdentityV3(client, golangsdk.EndpointOpts{})
+	if err != nil {
+		return "", err
+	}
+
+	allPages, err := projects.List(identityClient, projects.ListOpts{Name: name}).AllPages()
+	if err != nil {
+		return "", fmt.Errorf("Error querying project %s: %s", name, err)
+	}
+
+	allProjects, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		return "", fmt.Errorf("Error extracting project %s: %s", name, err)
+	}
+
+	if len(allProjects) != 1 {
+		return "", fmt.Errorf("Expected exactly one project named %s, got %d", name, len(allProjects))
+	}
+
+	return allProjects[0].ID, nil
+}
+
+// akskCredential is a set of temporary credentials issued by IAM.
+type akskCredential struct {
+	Access        string `json:"access"`
+	Secret        string `json:"secret"`
+	SecurityToken string `json:"securitytoken"`
+	ExpiresAt     string `json:"expires_at"`
+}
+
+// akskAssumeRole obtains temporary credentials for the agency agencyName
+// created by the domain domainName.
+func akskAssumeRole(client *golangsdk.ProviderClient, domainName, agencyName string) (akskCredential, error) {
+	reqBody := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"assume_role"},
+				"assume_role": map[string]interface{}{
+					"domain_name":      domainName,
+					"agency_name":      agencyName,
+					"duration_seconds": 3600,
+				},
+			},
+		},
+	}
+
+	var resBody struct {
+		Credential akskCredential `json:"credential"`
+	}
+	_, err := client.Request("POST", client.IdentityBase+"v3.0/OS-CREDENTIAL/securitytokens", &golangsdk.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &resBody,
+		OkCodes:      []int{201},
+	})
+	if err != nil {
+		return akskCredential{}, fmt.Errorf("Error assuming agency %s of domain %s: %s", agencyName, domainName, err)
+	}
+
+	if resBody.Credential.Access == "" || resBody.Credential.Secret == "" {
+		return akskCredential{}, fmt.Errorf("No temporary credentials returned for agency %s of domain %s", agencyName, domainName)
+	}
+
+	return resBody.Credential, nil
+}
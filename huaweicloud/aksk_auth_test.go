@@ -0,0 +1,362 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+func TestSignAKSKRequest_knownSignature(t *testing.T) {
+	req, err := http.NewRequest("POST",
+		"https://ecs.cn-north-1.myhuaweicloud.com/v2/0123456789abcdef/servers/action?name=web%20server&limit=10", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Project-Id", "0123456789abcdef")
+
+	signAKSKRequest(req, []byte(`{"os-stop":{}}`), "access-key", "secret-key",
+		time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC))
+
+	if v := req.Header.Get("X-Sdk-Date"); v != "20181001T120000Z" {
+		t.Fatalf("Unexpected X-Sdk-Date: %s", v)
+	}
+
+	expected := "SDK-HMAC-SHA256 Access=access-key, SignedHeaders=content-type;host;x-project-id;x-sdk-date, " +
+		"Signature=6b164d4231c2ebf1f4ca782ebe88dd310e9fc300e234ed5702ddc756fdfe318f"
+	if v := req.Header.Get("Authorization"); v != expected {
+		t.Fatalf("Unexpected Authorization header:\n got: %s\nwant: %s", v, expected)
+	}
+}
+
+func TestAKSKRoundTripper_httptest(t *testing.T) {
+	body := `{"key_id":"0d0466b0-e727-4d9c-b35d-f84bb474a37f"}`
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if err := testAKSKVerifyRequest(r, "access-key", "secret-key", body); err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if v := r.Header.Get("X-Project-Id"); v != "0123456789abcdef" {
+			t.Errorf("Unexpected X-Project-Id: %s", v)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := http.Client{
+		Transport: &AKSKRoundTripper{
+			Rt:        http.DefaultTransport,
+			AccessKey: "access-key",
+			SecretKey: "secret-key",
+			ProjectID: "0123456789abcdef",
+		},
+	}
+
+	req, err := http.NewRequest("POST", ts.URL+"/v1.0/0123456789abcdef/kms/describe-key?b=2&a=1&a=0",
+		strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected the signature to be accepted, got status %d", resp.StatusCode)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Fatalf("The original request must not be modified")
+	}
+
+	// A server holding a different secret must reject the signature.
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if err := testAKSKVerifyRequest(r, "access-key", "other-secret", body); err == nil {
+			t.Error("Expected the signature to be rejected with a different secret key")
+		}
+	})
+
+	req, _ = http.NewRequest("POST", ts.URL+"/v1.0/0123456789abcdef/kms/describe-key", strings.NewReader(body))
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if requests != 2 {
+		t.Fatalf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestHwAKSKAuth_projectLookup(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := testAKSKVerifyRequest(r, "access-key", "secret-key", ""); err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/v3/projects" || r.URL.Query().Get("name") != "cn-north-1" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("X-Auth-Token") != "" {
+			t.Error("No token must be sent with AK/SK authentication")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"projects":[{"id":"0123456789abcdef","name":"cn-north-1"}],"links":{"next":null}}`)
+	}))
+	defer ts.Close()
+
+	config := &Config{
+		AccessKey:        "access-key",
+		SecretKey:        "secret-key",
+		IdentityEndpoint: ts.URL + "/v3",
+		Region:           "cn-north-1",
+	}
+
+	if err := newhwClient(config); err != nil {
+		t.Fatal(err)
+	}
+
+	if config.HwClient.ProjectID != "0123456789abcdef" {
+		t.Fatalf("Unexpected project ID: %s", config.HwClient.ProjectID)
+	}
+
+	url, err := config.HwClient.EndpointLocator(golangsdk.EndpointOpts{Type: "compute", Region: "cn-north-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(url, "https://ecs.cn-north-1.") || !strings.HasSuffix(url, "/v2/0123456789abcdef/") {
+		t.Fatalf("Unexpected compute endpoint: %s", url)
+	}
+}
+
+// newTestAssumeRoleServer returns a stand-in for the identity calls made
+// when the provider assumes an agency, and records them in steps.
+func newTestAssumeRoleServer(t *testing.T, steps *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v3.0/OS-CREDENTIAL/securitytokens":
+			*steps = append(*steps, "assume")
+			body := `{"auth":{"identity":{"assume_role":{"agency_name":"admin_agency","domain_name":"tenant_domain","duration_seconds":3600},"methods":["assume_role"]}}}`
+			if err := testAKSKVerifyRequest(r, "access-key", "secret-key", body); err != nil {
+				t.Error(err)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"credential":{"access":"temp-access-key","secret":"temp-secret-key",`+
+				`"securitytoken":"temp-security-token","expires_at":"2018-10-01T13:00:00.000000Z"}}`)
+		case "/v3/projects":
+			*steps = append(*steps, "projects")
+			if err := testAKSKVerifyRequest(r, "temp-access-key", "temp-secret-key", ""); err != nil {
+				t.Error(err)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if !strings.Contains(r.Header.Get("Authorization"), "x-security-token") {
+				t.Error("The security token must be signed")
+			}
+			if v := r.Header.Get("X-Security-Token"); v != "temp-security-token" {
+				t.Errorf("Unexpected X-Security-Token: %s", v)
+			}
+
+			fmt.Fprint(w, `{"projects":[{"id":"fedcba9876543210","name":"cn-north-1"}],"links":{"next":null}}`)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestConfig_assumeRole(t *testing.T) {
+	var steps []string
+	ts := newTestAssumeRoleServer(t, &steps)
+	defer ts.Close()
+
+	config := &Config{
+		AccessKey:        "access-key",
+		SecretKey:        "secret-key",
+		AgencyName:       "admin_agency",
+		AgencyDomainName: "tenant_domain",
+		IdentityEndpoint: ts.URL + "/v3",
+		Region:           "cn-north-1",
+	}
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The credentials must be exchanged before anything else is requested.
+	if len(steps) < 2 || steps[0] != "assume" || steps[1] != "projects" {
+		t.Fatalf("Unexpected requests: %v", steps)
+	}
+	if config.AccessKey != "temp-access-key" || config.SecurityToken != "temp-security-token" {
+		t.Fatalf("Temporary credentials were not applied: %s %s", config.AccessKey, config.SecurityToken)
+	}
+	if config.HwClient.ProjectID != "fedcba9876543210" {
+		t.Fatalf("Unexpected project ID: %s", config.HwClient.ProjectID)
+	}
+}
+
+func TestConfig_assumeRoleDebugLog(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+	os.Setenv("OS_DEBUG", "1")
+	defer os.Unsetenv("OS_DEBUG")
+
+	var steps []string
+	ts := newTestAssumeRoleServer(t, &steps)
+	defer ts.Close()
+
+	config := &Config{
+		AccessKey:        "access-key",
+		SecretKey:        "secret-key",
+		AgencyName:       "admin_agency",
+		AgencyDomainName: "tenant_domain",
+		IdentityEndpoint: ts.URL + "/v3",
+		Region:           "cn-north-1",
+	}
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(buf.String(), "OS-CREDENTIAL/securitytokens") {
+		t.Fatalf("The requests were not logged:\n%s", buf.String())
+	}
+	for _, secret := range []string{"temp-secret-key", "temp-security-token"} {
+		if strings.Contains(buf.String(), secret) {
+			t.Fatalf("%s was logged:\n%s", secret, buf.String())
+		}
+	}
+}
+
+func TestConfig_assumeRoleRequiresAKSK(t *testing.T) {
+	config := &Config{
+		Username:         "admin",
+		Password:         "pwd",
+		AgencyName:       "admin_agency",
+		AgencyDomainName: "tenant_domain",
+		IdentityEndpoint: "https://iam.cn-north-1.myhuaweicloud.com/v3",
+		Region:           "cn-north-1",
+	}
+
+	if err := config.LoadAndValidate(); err == nil {
+		t.Fatal("Expected an error when assuming a role without access_key and secret_key")
+	}
+}
+
+func TestAKSKEndpointURL(t *testing.T) {
+	cases := []struct {
+		Type     string
+		Region   string
+		Expected string
+	}{
+		{"compute", "cn-north-1", "https://ecs.cn-north-1.myhuaweicloud.com/v2/0123456789abcdef/"},
+		{"network", "cn-north-1", "https://vpc.cn-north-1.myhuaweicloud.com/"},
+		{"ces", "cn-north-1", "https://ces.cn-north-1.myhuaweicloud.com/V1.0/"},
+		{"as", "cn-north-1", "https://as.cn-north-1.myhuaweicloud.com/autoscaling-api/v1/"},
+		{"dns", "", "https://dns.myhuaweicloud.com/"},
+	}
+
+	for _, tc := range cases {
+		url, err := akskEndpointURL(golangsdk.EndpointOpts{Type: tc.Type, Region: tc.Region},
+			"myhuaweicloud.com", "0123456789abcdef")
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Type, err)
+		}
+		if url != tc.Expected {
+			t.Fatalf("%s: expected %s, got %s", tc.Type, tc.Expected, url)
+		}
+	}
+
+	_, err := akskEndpointURL(golangsdk.EndpointOpts{Type: "unknown"}, "myhuaweicloud.com", "")
+	if err == nil {
+		t.Fatal("Expected an error for an unsupported service type")
+	}
+}
+
+func TestAKSKCloudDomain(t *testing.T) {
+	cases := map[string]string{
+		"https://iam.cn-north-1.myhuaweicloud.com/v3": "myhuaweicloud.com",
+		"https://iam.myhuaweicloud.com:443/v3":        "myhuaweicloud.com",
+		"https://iam.cn-north-1.example.com/v3":       "example.com",
+	}
+
+	for endpoint, expected := range cases {
+		domain, err := akskCloudDomain(endpoint, "cn-north-1")
+		if err != nil {
+			t.Fatalf("%s: %s", endpoint, err)
+		}
+		if domain != expected {
+			t.Fatalf("%s: expected %s, got %s", endpoint, expected, domain)
+		}
+	}
+}
+
+// testAKSKVerifyRequest checks the signature of r the way the API gateway
+// does, using the signed headers listed in the Authorization header.
+func testAKSKVerifyRequest(r *http.Request, accessKey, secretKey, expectedBody string) error {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, akskSignAlgorithm+" ") {
+		return fmt.Errorf("Unexpected Authorization header: %s", auth)
+	}
+
+	fields := make(map[string]string)
+	for _, f := range strings.Split(strings.TrimPrefix(auth, akskSignAlgorithm+" "), ", ") {
+		kv := strings.SplitN(f, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("Malformed Authorization header: %s", auth)
+		}
+		fields[kv[0]] = kv[1]
+	}
+
+	if fields["Access"] != accessKey {
+		return fmt.Errorf("Unexpected access key: %s", fields["Access"])
+	}
+
+	if r.Header.Get(akskHeaderSdkDate) == "" {
+		return fmt.Errorf("Missing %s header", akskHeaderSdkDate)
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if string(body) != expectedBody {
+		return fmt.Errorf("Unexpected body: %s", body)
+	}
+
+	signedHeaders := strings.Split(fields["SignedHeaders"], ";")
+	canonicalRequest := akskCanonicalRequest(r, body, signedHeaders)
+	signature := akskSignature(secretKey, akskStringToSign(canonicalRequest, r.Header.Get(akskHeaderSdkDate)))
+	if signature != fields["Signature"] {
+		return fmt.Errorf("Signature mismatch: got %s, want %s", fields["Signature"], signature)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/mitchellh/go-homedir"
+	"gopkg.in/yaml.v2"
+)
+
+// cloudsYAML is the content of a clouds.yaml or secure.yaml file.
+type cloudsYAML struct {
+	Clouds map[string]cloudProfile `yaml:"clouds"`
+}
+
+// cloudProfile is a named entry of the clouds section.
+type cloudProfile struct {
+	Auth           cloudAuth `yaml:"auth"`
+	RegionName     string    `yaml:"region_name"`
+	Interface      string    `yaml:"interface"`
+	Verify         *bool     `yaml:"verify"`
+	CACertFile     string    `yaml:"cacert"`
+	ClientCertFile string    `yaml:"cert"`
+	ClientKeyFile  string    `yaml:"key"`
+}
+
+// cloudAuth is the auth section of a profile. The access and secret keys are
+// HuaweiCloud extensions of the OpenStack format.
+type cloudAuth struct {
+	AuthURL           string `yaml:"auth_url"`
+	Token             string `yaml:"token"`
+	Username          string `yaml:"username"`
+	UserID            string `yaml:"user_id"`
+	Password          string `yaml:"password"`
+	ProjectName       string `yaml:"project_name"`
+	ProjectID         string `yaml:"project_id"`
+	DomainName        string `yaml:"domain_name"`
+	DomainID          string `yaml:"domain_id"`
+	UserDomainName    string `yaml:"user_domain_name"`
+	UserDomainID      string `yaml:"user_domain_id"`
+	ProjectDomainName string `yaml:"project_domain_name"`
+	ProjectDomainID   string `yaml:"project_domain_id"`
+	DefaultDomain     string `yaml:"default_domain"`
+	AccessKey         string `yaml:"access_key"`
+	SecretKey         string `yaml:"secret_key"`
+	SecurityToken     string `yaml:"security_token"`
+}
+
+// loadCloudsYAML fills in the settings not set by the provider arguments
+// from the c.Cloud profile. Values of secure.yaml take precedence over those
+// of clouds.yaml.
+func (c *Config) loadCloudsYAML() error {
+	cloudsFile := findCloudsFile("OS_CLIENT_CONFIG_FILE", "clouds.yaml")
+	if cloudsFile == "" {
+		return fmt.Errorf("Unable to find a clouds.yaml file for cloud %s", c.Cloud)
+	}
+
+	clouds, err := readCloudsFile(cloudsFile)
+	if err != nil {
+		return err
+	}
+
+	profile, ok := clouds.Clouds[c.Cloud]
+	if !ok {
+		return fmt.Errorf("Cloud %s not found in %s", c.Cloud, cloudsFile)
+	}
+	log.Printf("[DEBUG] Loaded cloud %s from %s", c.Cloud, cloudsFile)
+
+	if secureFile := findCloudsFile("OS_CLIENT_SECURE_FILE", "secure.yaml"); secureFile != "" {
+		secure, err := readCloudsFile(secureFile)
+		if err != nil {
+			return err
+		}
+
+		if secureProfile, ok := secure.Clouds[c.Cloud]; ok {
+			log.Printf("[DEBUG] Merging cloud %s from %s", c.Cloud, secureFile)
+			profile = mergeCloudProfiles(secureProfile, profile)
+		}
+	}
+
+	c.applyCloudProfile(profile)
+
+	return nil
+}
+
+// applyCloudProfile copies the settings of profile into c, leaving the
+// settings already set in c untouched. When c already has an AK/SK pair, the
+// credentials of the profile are skipped so that they cannot switch the
+// provider away from AK/SK authentication.
+func (c *Config) applyCloudProfile(profile cloudProfile) {
+	auth := profile.Auth
+
+	if c.AccessKey == "" || c.SecretKey == "" {
+		setIfEmpty(&c.Token, auth.Token)
+		setIfEmpty(&c.Username, auth.Username)
+		setIfEmpty(&c.UserID, auth.UserID)
+		setIfEmpty(&c.Password, auth.Password)
+		setIfEmpty(&c.AccessKey, auth.AccessKey)
+		setIfEmpty(&c.SecretKey, auth.SecretKey)
+		setIfEmpty(&c.SecurityToken, auth.SecurityToken)
+	}
+
+	setIfEmpty(&c.IdentityEndpoint, auth.AuthURL)
+	setIfEmpty(&c.TenantName, auth.ProjectName)
+	setIfEmpty(&c.TenantID, auth.ProjectID)
+	setIfEmpty(&c.DomainName, firstNonEmpty(auth.DomainName, auth.UserDomainName, auth.ProjectDomainName, auth.DefaultDomain))
+	setIfEmpty(&c.DomainID, firstNonEmpty(auth.DomainID, auth.UserDomainID, auth.ProjectDomainID))
+
+	setIfEmpty(&c.Region, profile.RegionName)
+	setIfEmpty(&c.EndpointType, profile.Interface)
+	setIfEmpty(&c.CACertFile, profile.CACertFile)
+	setIfEmpty(&c.ClientCertFile, profile.ClientCertFile)
+	setIfEmpty(&c.ClientKeyFile, profile.ClientKeyFile)
+
+	if !c.Insecure && !c.insecureSet && profile.Verify != nil {
+		c.Insecure = !*profile.Verify
+	}
+}
+
+// mergeCloudProfiles returns override, with the settings it lacks taken from
+// base.
+func mergeCloudProfiles(override, base cloudProfile) cloudProfile {
+	merged := override
+	mergedAuth := &merged.Auth
+	baseAuth := base.Auth
+
+	setIfEmpty(&mergedAuth.AuthURL, baseAuth.AuthURL)
+	setIfEmpty(&mergedAuth.Token, baseAuth.Token)
+	setIfEmpty(&mergedAuth.Username, baseAuth.Username)
+	setIfEmpty(&mergedAuth.UserID, baseAuth.UserID)
+	setIfEmpty(&mergedAuth.Password, baseAuth.Password)
+	setIfEmpty(&mergedAuth.ProjectName, baseAuth.ProjectName)
+	setIfEmpty(&mergedAuth.ProjectID, baseAuth.ProjectID)
+	setIfEmpty(&mergedAuth.DomainName, baseAuth.DomainName)
+	setIfEmpty(&mergedAuth.DomainID, baseAuth.DomainID)
+	setIfEmpty(&mergedAuth.UserDomainName, baseAuth.UserDomainName)
+	setIfEmpty(&mergedAuth.UserDomainID, baseAuth.UserDomainID)
+	setIfEmpty(&mergedAuth.ProjectDomainName, baseAuth.ProjectDomainName)
+	setIfEmpty(&mergedAuth.ProjectDomainID, baseAuth.ProjectDomainID)
+	setIfEmpty(&mergedAuth.DefaultDomain, baseAuth.DefaultDomain)
+	setIfEmpty(&mergedAuth.AccessKey, baseAuth.AccessKey)
+	setIfEmpty(&mergedAuth.SecretKey, baseAuth.SecretKey)
+	setIfEmpty(&mergedAuth.SecurityToken, baseAuth.SecurityToken)
+
+	setIfEmpty(&merged.RegionName, base.RegionName)
+	setIfEmpty(&merged.Interface, base.Interface)
+	setIfEmpty(&merged.CACertFile, base.CACertFile)
+	setIfEmpty(&merged.ClientCertFile, base.ClientCertFile)
+	setIfEmpty(&merged.ClientKeyFile, base.ClientKeyFile)
+	if merged.Verify == nil {
+		merged.Verify = base.Verify
+	}
+
+	return merged
+}
+
+// findCloudsFile returns the file named by the envVar environment variable,
+// or else the first file called name in the current directory,
+// ~/.config/openstack and /etc/openstack.
+func findCloudsFile(envVar, name string) string {
+	if v := os.Getenv(envVar); v != "" {
+		return v
+	}
+
+	dirs := []string{"."}
+	if home, err := homedir.Dir(); err == nil {
+		dirs = append(dirs, filepath.Join(home, ".config", "openstack"))
+	}
+	dirs = append(dirs, "/etc/openstack")
+
+	for _, dir := range dirs {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+
+	return ""
+}
+
+func readCloudsFile(path string) (cloudsYAML, error) {
+	var clouds cloudsYAML
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return clouds, fmt.Errorf("Error reading %s: %s", path, err)
+	}
+
+	if err := yaml.Unmarshal(content, &clouds); err != nil {
+		return clouds, fmt.Errorf("Error parsing %s: %s", path, err)
+	}
+
+	return clouds, nil
+}
+
+func setIfEmpty(dst *string, value string) {
+	if *dst == "" {
+		*dst = value
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,162 @@
+package huaweicloud
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func testCloudsYAMLFixtures() func() {
+	os.Setenv("OS_CLIENT_CONFIG_FILE", "test-fixtures/clouds.yaml")
+	os.Setenv("OS_CLIENT_SECURE_FILE", "test-fixtures/secure.yaml")
+
+	return func() {
+		os.Unsetenv("OS_CLIENT_CONFIG_FILE")
+		os.Unsetenv("OS_CLIENT_SECURE_FILE")
+	}
+}
+
+func TestConfig_loadCloudsYAML(t *testing.T) {
+	defer testCloudsYAMLFixtures()()
+
+	config := &Config{Cloud: "hwc"}
+	if err := config.loadCloudsYAML(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][2]string{
+		"auth_url":      {config.IdentityEndpoint, "https://iam.cn-north-1.myhuaweicloud.com/v3"},
+		"region":        {config.Region, "cn-north-1"},
+		"endpoint_type": {config.EndpointType, "internal"},
+		"user_name":     {config.Username, "terraform"},
+		"tenant_name":   {config.TenantName, "cn-north-1"},
+		"domain_name":   {config.DomainName, "terraform_domain"},
+		// secure.yaml takes precedence over clouds.yaml
+		"password": {config.Password, "secure-password"},
+	}
+	for k, v := range expected {
+		if v[0] != v[1] {
+			t.Errorf("Expected %s to be %q, got %q", k, v[1], v[0])
+		}
+	}
+
+	if !config.Insecure {
+		t.Errorf("Expected verify: false to make the config insecure")
+	}
+}
+
+func TestConfig_loadCloudsYAMLSecureOnlyKeys(t *testing.T) {
+	defer testCloudsYAMLFixtures()()
+
+	config := &Config{Cloud: "hwc-aksk"}
+	if err := config.loadCloudsYAML(); err != nil {
+		t.Fatal(err)
+	}
+
+	if config.AccessKey != "secure-access-key" || config.SecretKey != "secure-secret-key" {
+		t.Errorf("Expected the keys of secure.yaml, got %q and %q", config.AccessKey, config.SecretKey)
+	}
+	if config.TenantID != "0123456789abcdef" || config.DomainID != "fedcba9876543210" {
+		t.Errorf("Expected the project and domain of clouds.yaml, got %q and %q", config.TenantID, config.DomainID)
+	}
+	if config.Insecure {
+		t.Errorf("Expected the config to verify certificates by default")
+	}
+}
+
+func TestConfig_loadCloudsYAMLProviderOverrides(t *testing.T) {
+	defer testCloudsYAMLFixtures()()
+
+	config := &Config{
+		Cloud:      "hwc",
+		Region:     "cn-east-2",
+		Password:   "provider-password",
+		DomainName: "provider_domain",
+	}
+	if err := config.loadCloudsYAML(); err != nil {
+		t.Fatal(err)
+	}
+
+	if config.Region != "cn-east-2" {
+		t.Errorf("Expected the provider region to win, got %q", config.Region)
+	}
+	if config.Password != "provider-password" {
+		t.Errorf("Expected the provider password to win, got %q", config.Password)
+	}
+	if config.DomainName != "provider_domain" {
+		t.Errorf("Expected the provider domain to win, got %q", config.DomainName)
+	}
+	if config.Username != "terraform" {
+		t.Errorf("Expected the unset user name to come from clouds.yaml, got %q", config.Username)
+	}
+}
+
+func TestConfig_loadCloudsYAMLProviderAKSK(t *testing.T) {
+	defer testCloudsYAMLFixtures()()
+
+	config := &Config{
+		Cloud:     "hwc",
+		AccessKey: "provider-access-key",
+		SecretKey: "provider-secret-key",
+	}
+	if err := config.loadCloudsYAML(); err != nil {
+		t.Fatal(err)
+	}
+
+	if config.Username != "" || config.Password != "" {
+		t.Errorf("Expected no user name and password from clouds.yaml, got %q and %q", config.Username, config.Password)
+	}
+	if !config.useAKSKAuth() {
+		t.Errorf("Expected the provider AK/SK to be used for authentication")
+	}
+	if config.IdentityEndpoint != "https://iam.cn-north-1.myhuaweicloud.com/v3" || config.TenantName != "cn-north-1" {
+		t.Errorf("Expected the auth_url and project of clouds.yaml, got %q and %q", config.IdentityEndpoint, config.TenantName)
+	}
+}
+
+func TestConfig_loadCloudsYAMLExplicitSecure(t *testing.T) {
+	defer testCloudsYAMLFixtures()()
+
+	// insecure = false set in the provider block wins over verify: false.
+	config := &Config{Cloud: "hwc", insecureSet: true}
+	if err := config.loadCloudsYAML(); err != nil {
+		t.Fatal(err)
+	}
+
+	if config.Insecure {
+		t.Errorf("Expected the explicit insecure = false to win over clouds.yaml")
+	}
+}
+
+func TestProvider_insecureSet(t *testing.T) {
+	if os.Getenv("OS_INSECURE") != "" {
+		t.Skip("OS_INSECURE sets insecure")
+	}
+
+	s := Provider().(*schema.Provider).Schema
+	cases := []struct {
+		raw map[string]interface{}
+		set bool
+	}{
+		{map[string]interface{}{}, false},
+		{map[string]interface{}{"insecure": false}, true},
+		{map[string]interface{}{"insecure": true}, true},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, s, tc.raw)
+		if _, set := d.GetOkExists("insecure"); set != tc.set {
+			t.Errorf("%v: expected insecure to be set: %t, got %t", tc.raw, tc.set, set)
+		}
+	}
+}
+
+func TestConfig_loadCloudsYAMLUnknownCloud(t *testing.T) {
+	defer testCloudsYAMLFixtures()()
+
+	config := &Config{Cloud: "unknown"}
+	if err := config.loadCloudsYAML(); err == nil {
+		t.Fatal("Expected an error for a cloud missing from clouds.yaml")
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -27,6 +30,9 @@ import (
 type Config struct {
 	AccessKey        string
 	SecretKey        string
+	SecurityToken    string
+	AgencyName       string
+	AgencyDomainName string
 	CACertFile       string
 	ClientCertFile   string
 	ClientKeyFile    string
@@ -36,6 +42,7 @@ type Config struct {
 	EndpointType     string
 	IdentityEndpoint string
 	Insecure         bool
+	insecureSet      bool
 	Password         string
 	Region           string
 	Swauth           bool
@@ -46,12 +53,34 @@ type Config struct {
 	UserID           string
 	useOctavia       bool
 
+	// Endpoints maps service names to root URLs which are used instead of
+	// looking the services up in the catalog.
+	Endpoints map[string]string
+
+	// MaxRetries and RetryMaxWait bound the retries of throttled and failed
+	// requests.
+	MaxRetries   int
+	RetryMaxWait time.Duration
+
+	// MaxRequestsPerSecond limits the request rate of every service, unless
+	// the service has its own limit in RateLimits. Zero means unlimited.
+	MaxRequestsPerSecond int
+	RateLimits           map[string]int
+	rateLimiter          *rateLimiter
+
 	OsClient *gophercloud.ProviderClient
 	HwClient *golangsdk.ProviderClient
 	s3sess   *session.Session
 }
 
 func (c *Config) LoadAndValidate() error {
+	if c.Cloud != "" {
+		err := c.loadCloudsYAML()
+		if err != nil {
+			return err
+		}
+	}
+
 	validEndpoint := false
 	validEndpoints := []string{
 		"internal", "internalURL",
@@ -69,15 +98,51 @@ func (c *Config) LoadAndValidate() error {
 	if !validEndpoint {
 		return fmt.Errorf("Invalid endpoint type provided")
 	}
-	err := newopenstackClient(c)
+
+	for service := range c.Endpoints {
+		if _, ok := serviceEndpointOverrides[service]; !ok {
+			return fmt.Errorf("Invalid endpoints key %q, expected one of %s", service, strings.Join(validEndpointOverrides(), ", "))
+		}
+	}
+
+	for service, rate := range c.RateLimits {
+		if _, ok := serviceEndpointOverrides[service]; !ok {
+			return fmt.Errorf("Invalid rate_limits key %q, expected one of %s", service, strings.Join(validEndpointOverrides(), ", "))
+		}
+		if rate < 0 {
+			return fmt.Errorf("Invalid rate limit %d for %s, must not be negative", rate, service)
+		}
+	}
+	if c.MaxRequestsPerSecond < 0 {
+		return fmt.Errorf("Invalid max_requests_per_second %d, must not be negative", c.MaxRequestsPerSecond)
+	}
+	c.rateLimiter = c.newRateLimiter()
+
+	if c.AgencyName != "" {
+		err := c.assumeRole()
+		if err != nil {
+			return err
+		}
+	}
+
+	// The golangsdk client goes first: with AK/SK authentication it resolves
+	// the project the OpenStack client is then scoped to.
+	err := newhwClient(c)
 	if err != nil {
 		return err
 	}
 
-	return newhwClient(c)
+	return newopenstackClient(c)
 
 }
 
+// useAKSKAuth reports whether requests are signed with the AK/SK pair
+// instead of authenticating against Keystone, which is the case when no
+// password or token is supplied.
+func (c *Config) useAKSKAuth() bool {
+	return c.AccessKey != "" && c.SecretKey != "" && c.Password == "" && c.Token == ""
+}
+
 func newopenstackClient(c *Config) error {
 	ao := gophercloud.AuthOptions{
 		DomainID:         c.DomainID,
@@ -142,14 +207,42 @@ func newopenstackClient(c *Config) error {
 
 	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config}
 	client.HTTPClient = http.Client{
-		Transport: &LogRoundTripper{
-			Rt:      transport,
-			OsDebug: osDebug,
+		Transport: &RetryRoundTripper{
+			Rt: &RateLimitRoundTripper{
+				Rt: &LogRoundTripper{
+					Rt:      transport,
+					OsDebug: osDebug,
+				},
+				Limiter: c.rateLimiter,
+			},
+			MaxRetries: c.MaxRetries,
+			MaxWait:    c.RetryMaxWait,
 		},
 	}
 
-	// If using Swift Authentication, there's no need to validate authentication normally.
-	if !c.Swauth {
+	if c.useAKSKAuth() {
+		domain, err := akskCloudDomain(c.IdentityEndpoint, c.Region)
+		if err != nil {
+			return err
+		}
+
+		projectID := c.HwClient.ProjectID
+		client.HTTPClient.Transport = &AKSKRoundTripper{
+			Rt:            client.HTTPClient.Transport,
+			AccessKey:     c.AccessKey,
+			SecretKey:     c.SecretKey,
+			SecurityToken: c.SecurityToken,
+			ProjectID:     projectID,
+		}
+		client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+			return akskEndpointURL(golangsdk.EndpointOpts{
+				Type:   opts.Type,
+				Name:   opts.Name,
+				Region: opts.Region,
+			}, domain, projectID)
+		}
+	} else if !c.Swauth {
+		// If using Swift Authentication, there's no need to validate authentication normally.
 		err = openstack.Authenticate(client, ao)
 		if err != nil {
 			return err
@@ -185,8 +278,8 @@ func newopenstackClient(c *Config) error {
 		awsConfig := &aws.Config{
 			Credentials: creds,
 			Region:      aws.String(c.Region),
-			//MaxRetries:       aws.Int(c.MaxRetries),
-			HTTPClient: cleanhttp.DefaultClient(),
+			MaxRetries:  aws.Int(c.MaxRetries),
+			HTTPClient:  cleanhttp.DefaultClient(),
 			//S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 		}
 
@@ -225,19 +318,142 @@ func newhwClient(c *Config) error {
 		UserID:           c.UserID,
 	}
 
-	client, err := huaweisdk.NewClient(ao.IdentityEndpoint)
+	client, err := newhwProviderClient(c)
 	if err != nil {
 		return err
 	}
 
+	if c.useAKSKAuth() {
+		err = hwAKSKAuth(c, client)
+		if err != nil {
+			return err
+		}
+	} else if !c.Swauth {
+		// If using Swift Authentication, there's no need to validate authentication normally.
+		err = huaweisdk.Authenticate(client, ao)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.HwClient = client
+	//fmt.Printf("[DEBUG] Region: %s.\n", c.Region)
+
+	return nil
+}
+
+// hwAKSKAuth sets up client to sign its requests with the AK/SK pair and to
+// locate endpoints without a service catalog. The project defaults to the one
+// named after the region when neither tenant_id nor tenant_name is set.
+func hwAKSKAuth(c *Config, client *golangsdk.ProviderClient) error {
+	if c.IdentityEndpoint == "" {
+		return fmt.Errorf("auth_url is required when authenticating with access_key and secret_key")
+	}
+	if c.Region == "" {
+		return fmt.Errorf("region is required when authenticating with access_key and secret_key")
+	}
+
+	domain, err := akskCloudDomain(c.IdentityEndpoint, c.Region)
+	if err != nil {
+		return err
+	}
+
+	signer := &AKSKRoundTripper{
+		Rt:            client.HTTPClient.Transport,
+		AccessKey:     c.AccessKey,
+		SecretKey:     c.SecretKey,
+		SecurityToken: c.SecurityToken,
+	}
+	client.HTTPClient.Transport = signer
+
+	projectID := c.TenantID
+	if projectID == "" {
+		projectName := c.TenantName
+		if projectName == "" {
+			projectName = c.Region
+		}
+
+		projectID, err = akskProjectID(client, projectName)
+		if err != nil {
+			return err
+		}
+	}
+	log.Printf("[DEBUG] Using project %s for AK/SK authentication", projectID)
+
+	signer.ProjectID = projectID
+	client.ProjectID = projectID
+	client.EndpointLocator = func(opts golangsdk.EndpointOpts) (string, error) {
+		return akskEndpointURL(opts, domain, projectID)
+	}
+
+	return nil
+}
+
+// assumeRole exchanges the configured AK/SK pair for temporary credentials
+// of the agency in assume_role, which are then used for every client.
+func (c *Config) assumeRole() error {
+	if !c.useAKSKAuth() {
+		return fmt.Errorf("assume_role requires access_key and secret_key, without password or token")
+	}
+	if c.IdentityEndpoint == "" {
+		return fmt.Errorf("auth_url is required when using assume_role")
+	}
+
+	client, err := newhwProviderClient(c)
+	if err != nil {
+		return err
+	}
+	client.HTTPClient.Transport = &AKSKRoundTripper{
+		Rt:            client.HTTPClient.Transport,
+		AccessKey:     c.AccessKey,
+		SecretKey:     c.SecretKey,
+		SecurityToken: c.SecurityToken,
+	}
+
+	log.Printf("[DEBUG] Assuming agency %s of domain %s", c.AgencyName, c.AgencyDomainName)
+	credential, err := akskAssumeRole(client, c.AgencyDomainName, c.AgencyName)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Temporary credentials of agency %s expire at %s", c.AgencyName, credential.ExpiresAt)
+
+	c.AccessKey = credential.Access
+	c.SecretKey = credential.Secret
+	c.SecurityToken = credential.SecurityToken
+
+	return nil
+}
+
+// newhwProviderClient prepares an unauthenticated golangsdk provider client
+// with the configured TLS settings and request logging.
+func newhwProviderClient(c *Config) (*golangsdk.ProviderClient, error) {
+	client, err := huaweisdk.NewClient(c.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set UserAgent
 	client.UserAgent.Prepend(terraform.UserAgentString())
 
+	transport, err := newhwTransport(c)
+	if err != nil {
+		return nil, err
+	}
+	client.HTTPClient = http.Client{
+		Transport: transport,
+	}
+
+	return client, nil
+}
+
+// newhwTransport builds the unsigned transport of the golangsdk clients, with
+// the configured TLS settings, request logging, rate limiting and retries.
+func newhwTransport(c *Config) (http.RoundTripper, error) {
 	config := &tls.Config{}
 	if c.CACertFile != "" {
 		caCert, _, err := pathorcontents.Read(c.CACertFile)
 		if err != nil {
-			return fmt.Errorf("Error reading CA Cert: %s", err)
+			return nil, fmt.Errorf("Error reading CA Cert: %s", err)
 		}
 
 		caCertPool := x509.NewCertPool()
@@ -252,16 +468,16 @@ func newhwClient(c *Config) error {
 	if c.ClientCertFile != "" && c.ClientKeyFile != "" {
 		clientCert, _, err := pathorcontents.Read(c.ClientCertFile)
 		if err != nil {
-			return fmt.Errorf("Error reading Client Cert: %s", err)
+			return nil, fmt.Errorf("Error reading Client Cert: %s", err)
 		}
 		clientKey, _, err := pathorcontents.Read(c.ClientKeyFile)
 		if err != nil {
-			return fmt.Errorf("Error reading Client Key: %s", err)
+			return nil, fmt.Errorf("Error reading Client Key: %s", err)
 		}
 
 		cert, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		config.Certificates = []tls.Certificate{cert}
@@ -275,25 +491,17 @@ func newhwClient(c *Config) error {
 	}
 
 	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config}
-	client.HTTPClient = http.Client{
-		Transport: &LogRoundTripper{
-			Rt:      transport,
-			OsDebug: osDebug,
+	return &RetryRoundTripper{
+		Rt: &RateLimitRoundTripper{
+			Rt: &LogRoundTripper{
+				Rt:      transport,
+				OsDebug: osDebug,
+			},
+			Limiter: c.rateLimiter,
 		},
-	}
-
-	// If using Swift Authentication, there's no need to validate authentication normally.
-	if !c.Swauth {
-		err = huaweisdk.Authenticate(client, ao)
-		if err != nil {
-			return err
-		}
-	}
-
-	c.HwClient = client
-	//fmt.Printf("[DEBUG] Region: %s.\n", c.Region)
-
-	return nil
+		MaxRetries: c.MaxRetries,
+		MaxWait:    c.RetryMaxWait,
+	}, nil
 }
 
 type awsLogger struct{}
@@ -324,12 +532,17 @@ func (c *Config) computeS3conn(region string) (*s3.S3, error) {
 		return nil, fmt.Errorf("Missing credentials for Swift S3 Provider, need access_key and secret_key values for provider.")
 	}
 
-	client, err := openstack.NewNetworkV2(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
-	// Bit of a hack, seems the only way to compute this.
-	endpoint := strings.Replace(client.Endpoint, "//vpc", "//obs", 1)
+	endpoint, ok := c.endpointOverride("obs")
+	var err error
+	if !ok {
+		var client *gophercloud.ServiceClient
+		client, err = openstack.NewNetworkV2(c.OsClient, gophercloud.EndpointOpts{
+			Region:       c.determineRegion(region),
+			Availability: c.getEndpointType(),
+		})
+		// Bit of a hack, seems the only way to compute this.
+		endpoint = strings.Replace(client.Endpoint, "//vpc", "//obs", 1)
+	}
 
 	awsS3Sess := c.s3sess.Copy(&aws.Config{Endpoint: aws.String(endpoint)})
 	s3conn := s3.New(awsS3Sess)
@@ -337,7 +550,50 @@ func (c *Config) computeS3conn(region string) (*s3.S3, error) {
 	return s3conn, err
 }
 
+// computeOBSClient returns a client for the native OBS API. Its requests
+// are signed with the AK/SK pair, so access_key and secret_key are required.
+func (c *Config) computeOBSClient(region string) (*OBSClient, error) {
+	if c.AccessKey == "" || c.SecretKey == "" {
+		return nil, fmt.Errorf("Missing credentials for OBS, need access_key and secret_key values for provider.")
+	}
+
+	endpoint, ok := c.endpointOverride("obs")
+	if !ok {
+		client, err := huaweisdk.NewNetworkV2(c.HwClient, golangsdk.EndpointOpts{
+			Region:       c.determineRegion(region),
+			Availability: c.getHwEndpointType(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		// OBS is not in the catalog, its endpoint only differs from the
+		// VPC one by the service name.
+		endpoint = strings.Replace(client.Endpoint, "//vpc", "//obs", 1)
+	}
+
+	transport, err := newhwTransport(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OBSClient{
+		Endpoint: endpoint,
+		HTTPClient: http.Client{
+			Transport: &OBSRoundTripper{
+				Rt:            transport,
+				AccessKey:     c.AccessKey,
+				SecretKey:     c.SecretKey,
+				SecurityToken: c.SecurityToken,
+			},
+		},
+	}, nil
+}
+
 func (c *Config) blockStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("evs"); ok {
+		return c.osServiceClient("volume", endpoint+"v1/"+c.HwClient.ProjectID+"/", ""), nil
+	}
+
 	return openstack.NewBlockStorageV1(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getEndpointType(),
@@ -345,6 +601,10 @@ func (c *Config) blockStorageV1Client(region string) (*gophercloud.ServiceClient
 }
 
 func (c *Config) blockStorageV2Client(region string) (*gophercloud.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("evs"); ok {
+		return c.osServiceClient("volumev2", endpoint+"v2/"+c.HwClient.ProjectID+"/", ""), nil
+	}
+
 	return openstack.NewBlockStorageV2(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getEndpointType(),
@@ -352,6 +612,10 @@ func (c *Config) blockStorageV2Client(region string) (*gophercloud.ServiceClient
 }
 
 func (c *Config) computeV2Client(region string) (*gophercloud.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("ecs"); ok {
+		return c.osServiceClient("compute", endpoint+"v2/"+c.HwClient.ProjectID+"/", ""), nil
+	}
+
 	return openstack.NewComputeV2(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getEndpointType(),
@@ -359,6 +623,10 @@ func (c *Config) computeV2Client(region string) (*gophercloud.ServiceClient, err
 }
 
 func (c *Config) dnsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("dns"); ok {
+		return c.hwServiceClient("dns", endpoint, endpoint+"v2/"), nil
+	}
+
 	return huaweisdk.NewDNSV2(c.HwClient, golangsdk.EndpointOpts{
 		//Region:       c.determineRegion(region),
 		Region:       "",
@@ -374,6 +642,10 @@ func (c *Config) identityV3Client(region string) (*gophercloud.ServiceClient, er
 }
 
 func (c *Config) imageV2Client(region string) (*gophercloud.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("ims"); ok {
+		return c.osServiceClient("image", endpoint, endpoint+"v2/"), nil
+	}
+
 	return openstack.NewImageServiceV2(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getEndpointType(),
@@ -381,6 +653,10 @@ func (c *Config) imageV2Client(region string) (*gophercloud.ServiceClient, error
 }
 
 func (c *Config) networkingV1Client(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("vpc"); ok {
+		return c.hwServiceClient("network", endpoint, endpoint+"v1/"), nil
+	}
+
 	return huaweisdk.NewNetworkV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getHwEndpointType(),
@@ -388,12 +664,27 @@ func (c *Config) networkingV1Client(region string) (*golangsdk.ServiceClient, er
 }
 
 func (c *Config) networkingV2Client(region string) (*gophercloud.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("vpc"); ok {
+		return c.osServiceClient("network", endpoint, endpoint+"v2.0/"), nil
+	}
+
 	return openstack.NewNetworkV2(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getEndpointType(),
 	})
 }
 
+func (c *Config) networkingHwV2Client(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("vpc"); ok {
+		return c.hwServiceClient("network", endpoint, endpoint+"v2.0/"), nil
+	}
+
+	return huaweisdk.NewNetworkV2(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+}
+
 func (c *Config) objectStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
 	// If Swift Authentication is being used, return a swauth client.
 	if c.Swauth {
@@ -424,6 +715,10 @@ func (c *Config) databaseV1Client(region string) (*gophercloud.ServiceClient, er
 }
 
 func (c *Config) fwV2Client(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("vpc"); ok {
+		return c.hwServiceClient("network", endpoint, endpoint+"v2.0/"), nil
+	}
+
 	return huaweisdk.NewNetworkV2(c.HwClient, golangsdk.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getHwEndpointType(),
@@ -431,6 +726,10 @@ func (c *Config) fwV2Client(region string) (*golangsdk.ServiceClient, error) {
 }
 
 func (c *Config) loadElasticLoadBalancerClient(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("elb"); ok {
+		return c.hwServiceClient("compute", endpoint+"v1.0/", endpoint+"v1.0/"), nil
+	}
+
 	return huaweisdk.NewElasticLoadBalancer(c.HwClient, golangsdk.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getHwEndpointType(),
@@ -438,6 +737,10 @@ func (c *Config) loadElasticLoadBalancerClient(region string) (*golangsdk.Servic
 }
 
 func (c *Config) kmsKeyV1Client(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("kms"); ok {
+		return c.hwServiceClient("kms", endpoint+"v1.0/", endpoint+"v1.0/"), nil
+	}
+
 	return huaweisdk.NewKmsKeyV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getHwEndpointType(),
@@ -445,6 +748,10 @@ func (c *Config) kmsKeyV1Client(region string) (*golangsdk.ServiceClient, error)
 }
 
 func (c *Config) natV2Client(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("nat"); ok {
+		return c.hwServiceClient("network", endpoint, endpoint+"v2.0/"), nil
+	}
+
 	return huaweisdk.NewNatV2(c.HwClient, golangsdk.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getHwEndpointType(),
@@ -452,6 +759,10 @@ func (c *Config) natV2Client(region string) (*golangsdk.ServiceClient, error) {
 }
 
 func (c *Config) SmnV2Client(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("smn"); ok {
+		return c.hwServiceClient("smn", endpoint+"v2/"+c.HwClient.ProjectID+"/", endpoint+"v2/"+c.HwClient.ProjectID+"/notifications/"), nil
+	}
+
 	return huaweisdk.NewSmnServiceV2(c.HwClient, golangsdk.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getHwEndpointType(),
@@ -459,6 +770,10 @@ func (c *Config) SmnV2Client(region string) (*golangsdk.ServiceClient, error) {
 }
 
 func (c *Config) RdsV1Client(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("rds"); ok {
+		return c.hwServiceClient("rds", endpoint+"rds/v1/"+c.HwClient.ProjectID+"/", endpoint+"rds/v1/"+c.HwClient.ProjectID+"/"), nil
+	}
+
 	return huaweisdk.NewRdsServiceV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getHwEndpointType(),
@@ -466,12 +781,109 @@ func (c *Config) RdsV1Client(region string) (*golangsdk.ServiceClient, error) {
 }
 
 func (c *Config) loadCESClient(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("ces"); ok {
+		return c.hwServiceClient("ces", endpoint+"V1.0/", endpoint+"V1.0/"), nil
+	}
+
 	return huaweisdk.NewCESClient(c.HwClient, golangsdk.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getHwEndpointType(),
 	})
 }
 
+func (c *Config) autoscalingV1Client(region string) (*golangsdk.ServiceClient, error) {
+	if endpoint, ok := c.endpointOverride("as"); ok {
+		return c.hwServiceClient("as", endpoint+"autoscaling-api/v1/", ""), nil
+	}
+
+	return huaweisdk.NewAutoScalingService(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+}
+
+// serviceEndpointOverrides lists the services whose endpoint can be set in
+// the endpoints provider argument.
+var serviceEndpointOverrides = map[string]bool{
+	"as":  true,
+	"ces": true,
+	"dns": true,
+	"ecs": true,
+	"elb": true,
+	"evs": true,
+	"ims": true,
+	"kms": true,
+	"nat": true,
+	"obs": true,
+	"rds": true,
+	"smn": true,
+	"vpc": true,
+}
+
+func validEndpointOverrides() []string {
+	services := make([]string, 0, len(serviceEndpointOverrides))
+	for service := range serviceEndpointOverrides {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	return services
+}
+
+// newRateLimiter builds the limiter shared by the transports of all clients.
+// Services whose endpoint is overridden are recognized by the host of the
+// override.
+func (c *Config) newRateLimiter() *rateLimiter {
+	limiter := &rateLimiter{
+		Rate:         c.MaxRequestsPerSecond,
+		ServiceRates: c.RateLimits,
+		ServiceHosts: make(map[string]string),
+	}
+
+	for service, endpoint := range c.Endpoints {
+		if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+			limiter.ServiceHosts[u.Host] = service
+		}
+	}
+
+	return limiter
+}
+
+// endpointOverride returns the root URL set for service in the endpoints
+// provider argument, always ending with a slash.
+func (c *Config) endpointOverride(service string) (string, bool) {
+	endpoint, ok := c.Endpoints[service]
+	if !ok || endpoint == "" {
+		return "", false
+	}
+
+	log.Printf("[DEBUG] Using endpoint %s for service %s", endpoint, service)
+	return golangsdk.NormalizeURL(endpoint), true
+}
+
+// hwServiceClient builds a golangsdk service client for an endpoint set in
+// the endpoints provider argument, with the same type, endpoint and resource
+// base the SDK would derive from the catalog. An empty resourceBase defaults
+// to the endpoint.
+func (c *Config) hwServiceClient(clientType, endpoint, resourceBase string) *golangsdk.ServiceClient {
+	return &golangsdk.ServiceClient{
+		ProviderClient: c.HwClient,
+		Endpoint:       endpoint,
+		ResourceBase:   resourceBase,
+		Type:           clientType,
+	}
+}
+
+// osServiceClient is the gophercloud counterpart of hwServiceClient.
+func (c *Config) osServiceClient(clientType, endpoint, resourceBase string) *gophercloud.ServiceClient {
+	return &gophercloud.ServiceClient{
+		ProviderClient: c.OsClient,
+		Endpoint:       endpoint,
+		ResourceBase:   resourceBase,
+		Type:           clientType,
+	}
+}
+
 func (c *Config) getEndpointType() gophercloud.Availability {
 	if c.EndpointType == "internal" || c.EndpointType == "internalURL" {
 		return gophercloud.AvailabilityInternal
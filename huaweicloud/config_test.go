@@ -0,0 +1,113 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/kms/v1/keys"
+)
+
+func TestConfig_endpointOverrides(t *testing.T) {
+	config := &Config{
+		HwClient: &golangsdk.ProviderClient{ProjectID: "0123456789abcdef"},
+		OsClient: &gophercloud.ProviderClient{},
+		Endpoints: map[string]string{
+			"as":  "https://as.example.com",
+			"ces": "https://ces.example.com/",
+			"dns": "https://dns.example.com",
+			"ecs": "https://ecs.example.com",
+			"elb": "https://elb.example.com",
+			"evs": "https://evs.example.com",
+			"ims": "https://ims.example.com",
+			"kms": "https://kms.example.com",
+			"nat": "https://nat.example.com",
+			"rds": "https://rds.example.com",
+			"smn": "https://smn.example.com",
+			"vpc": "https://vpc.example.com",
+		},
+	}
+
+	hwClients := map[string]func(string) (*golangsdk.ServiceClient, error){
+		"https://as.example.com/autoscaling-api/v1/":                 config.autoscalingV1Client,
+		"https://ces.example.com/V1.0/":                              config.loadCESClient,
+		"https://dns.example.com/v2/":                                config.dnsV2Client,
+		"https://elb.example.com/v1.0/":                              config.loadElasticLoadBalancerClient,
+		"https://kms.example.com/v1.0/":                              config.kmsKeyV1Client,
+		"https://nat.example.com/v2.0/":                              config.natV2Client,
+		"https://rds.example.com/rds/v1/0123456789abcdef/":           config.RdsV1Client,
+		"https://smn.example.com/v2/0123456789abcdef/notifications/": config.SmnV2Client,
+		"https://vpc.example.com/v1/":                                config.networkingV1Client,
+		"https://vpc.example.com/v2.0/":                              config.fwV2Client,
+	}
+	for expected, newClient := range hwClients {
+		client, err := newClient("cn-north-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if client.ResourceBaseURL() != expected {
+			t.Errorf("Expected resource base %s, got %s", expected, client.ResourceBaseURL())
+		}
+	}
+
+	osClients := map[string]func(string) (*gophercloud.ServiceClient, error){
+		"https://ecs.example.com/v2/0123456789abcdef/": config.computeV2Client,
+		"https://evs.example.com/v2/0123456789abcdef/": config.blockStorageV2Client,
+		"https://ims.example.com/v2/":                  config.imageV2Client,
+		"https://vpc.example.com/v2.0/":                config.networkingV2Client,
+	}
+	for expected, newClient := range osClients {
+		client, err := newClient("cn-north-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if client.ResourceBaseURL() != expected {
+			t.Errorf("Expected resource base %s, got %s", expected, client.ResourceBaseURL())
+		}
+	}
+}
+
+func TestConfig_endpointOverrideMockServer(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1.0/0123456789abcdef/kms/describe-key" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"key_info":{"key_id":"0d0466b0-e727-4d9c-b35d-f84bb474a37f","key_alias":"mock"}}`)
+	}))
+	defer ts.Close()
+
+	config := &Config{
+		HwClient:  &golangsdk.ProviderClient{ProjectID: "0123456789abcdef"},
+		Endpoints: map[string]string{"kms": ts.URL},
+	}
+
+	client, err := config.kmsKeyV1Client("cn-north-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := keys.Get(client, "0d0466b0-e727-4d9c-b35d-f84bb474a37f").ExtractKeyInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.KeyAlias != "mock" {
+		t.Fatalf("Unexpected key: %#v", key)
+	}
+}
+
+func TestConfig_endpointOverrideInvalidService(t *testing.T) {
+	config := &Config{
+		Endpoints: map[string]string{"unknown": "https://unknown.example.com"},
+	}
+
+	if err := config.LoadAndValidate(); err == nil {
+		t.Fatal("Expected an error for an unknown service in endpoints")
+	}
+}
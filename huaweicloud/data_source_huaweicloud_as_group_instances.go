@@ -0,0 +1,126 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceASGroupInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceASGroupInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"life_cycle_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"INSERVICE", "PENDING", "REMOVING"})
+				},
+			},
+			"health_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"INITIALIZING", "NORMAL", "ERROR"})
+				},
+			},
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"life_cycle_state": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scaling_configuration_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scaling_configuration_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceASGroupInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	asgID := d.Get("scaling_group_id").(string)
+	listOpts := ASInstancesListOpts{
+		LifeCycleStatus: d.Get("life_cycle_state").(string),
+		HealthStatus:    d.Get("health_status").(string),
+	}
+
+	allIns, err := listASGroupInstances(asClient, asgID, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve instances of AS Group %s: %s", asgID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d instances of AS Group %s", len(allIns), asgID)
+
+	ids := make([]string, 0, len(allIns))
+	insList := make([]map[string]interface{}, 0, len(allIns))
+	for _, ins := range allIns {
+		ids = append(ids, ins.ID)
+		insList = append(insList, map[string]interface{}{
+			"instance_id":                ins.ID,
+			"instance_name":              ins.Name,
+			"life_cycle_state":           ins.LifeCycleStatus,
+			"health_status":              ins.HealthStatus,
+			"scaling_configuration_id":   ins.ConfigurationID,
+			"scaling_configuration_name": ins.ConfigurationName,
+			"create_time":                ins.CreateTime,
+		})
+	}
+
+	d.SetId(asgID)
+	d.Set("ids", ids)
+	if err := d.Set("instances", insList); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving instances to state for AS Group (%s): %s", asgID, err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccASGroupInstancesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccASGroupInstancesDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASGroupInstancesDataSourceID("data.huaweicloud_as_group_instances.group_instances"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_as_group_instances.group_instances", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.huaweicloud_as_group_instances.group_instances", "ids.0",
+						"huaweicloud_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_as_group_instances.group_instances", "instances.0.life_cycle_state", "INSERVICE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckASGroupInstancesDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find AS group instances data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("AS group instances data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccASGroupInstancesDataSource_basic = fmt.Sprintf(`
+%s
+
+data "huaweicloud_as_group_instances" "group_instances" {
+  scaling_group_id = "${huaweicloud_as_group_instance_attachment.attach_1.scaling_group_id}"
+}
+`, testAccASGroupInstanceAttachment_basic)
@@ -0,0 +1,164 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/kms/v1/keys"
+)
+
+func dataSourceKmsKeysV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKmsKeysV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"key_state": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateKmsKeyStatus,
+			},
+			"key_alias_regex": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+			},
+			"default_key_flag": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"0", "1"})
+				},
+			},
+			"created_after": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Timestamp,
+			},
+			"created_before": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Timestamp,
+			},
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"aliases": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"states": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// kmsKeysV1Filter holds the client-side filters of the kms keys data source.
+// Empty fields match every key.
+type kmsKeysV1Filter struct {
+	AliasRegex     *regexp.Regexp
+	DefaultKeyFlag string
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+}
+
+func dataSourceKmsKeysV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud kms key client: %s", err)
+	}
+
+	filter := kmsKeysV1Filter{
+		DefaultKeyFlag: d.Get("default_key_flag").(string),
+	}
+	if v, ok := d.GetOk("key_alias_regex"); ok {
+		filter.AliasRegex = regexp.MustCompile(v.(string))
+	}
+	if v, ok := d.GetOk("created_after"); ok {
+		filter.CreatedAfter, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("created_before"); ok {
+		filter.CreatedBefore, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	listOpts := keys.ListOpts{
+		KeyState: d.Get("key_state").(string),
+	}
+	allKeys := []keys.Key{}
+	for {
+		v, err := keys.ListAllKeys(kmsKeyV1Client, listOpts).ExtractListKey()
+		if err != nil {
+			return fmt.Errorf("Error listing HuaweiCloud keys: %s", err)
+		}
+		allKeys = append(allKeys, v.KeyDetails...)
+
+		if v.Truncated != "true" || v.NextMarker == "" {
+			break
+		}
+		listOpts.Marker = v.NextMarker
+	}
+
+	filteredKeys := filterKmsKeysV1(allKeys, filter)
+	log.Printf("[DEBUG] %d of %d Kms keys match the filter", len(filteredKeys), len(allKeys))
+
+	ids := make([]string, 0, len(filteredKeys))
+	aliases := make([]string, 0, len(filteredKeys))
+	states := make([]string, 0, len(filteredKeys))
+	for _, key := range filteredKeys {
+		ids = append(ids, key.KeyID)
+		aliases = append(aliases, key.KeyAlias)
+		states = append(states, key.KeyState)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("aliases", aliases)
+	d.Set("states", states)
+
+	return nil
+}
+
+// filterKmsKeysV1 returns the keys which match the filter, in their order.
+func filterKmsKeysV1(allKeys []keys.Key, filter kmsKeysV1Filter) []keys.Key {
+	filteredKeys := []keys.Key{}
+	for _, key := range allKeys {
+		if filter.AliasRegex != nil && !filter.AliasRegex.MatchString(key.KeyAlias) {
+			continue
+		}
+		if filter.DefaultKeyFlag != "" && key.DefaultKeyFlag != filter.DefaultKeyFlag {
+			continue
+		}
+
+		if !filter.CreatedAfter.IsZero() || !filter.CreatedBefore.IsZero() {
+			// The creation date is a timestamp in milliseconds.
+			ms, err := strconv.ParseInt(key.CreationDate, 10, 64)
+			if err != nil {
+				log.Printf("[WARN] Ignoring Kms key %s with creation date %q", key.KeyID, key.CreationDate)
+				continue
+			}
+			created := time.Unix(0, ms*int64(time.Millisecond))
+			if !filter.CreatedAfter.IsZero() && created.Before(filter.CreatedAfter) {
+				continue
+			}
+			if !filter.CreatedBefore.IsZero() && !created.Before(filter.CreatedBefore) {
+				continue
+			}
+		}
+
+		filteredKeys = append(filteredKeys, key)
+	}
+
+	return filteredKeys
+}
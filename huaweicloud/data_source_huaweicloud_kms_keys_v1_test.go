@@ -0,0 +1,136 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/huaweicloud/golangsdk/openstack/kms/v1/keys"
+)
+
+func TestFilterKmsKeysV1(t *testing.T) {
+	allKeys := []keys.Key{
+		{KeyID: "1", KeyAlias: "app-data", DefaultKeyFlag: "0", CreationDate: "1525132800000"},
+		{KeyID: "2", KeyAlias: "app-logs", DefaultKeyFlag: "0", CreationDate: "1527811200000"},
+		{KeyID: "3", KeyAlias: "obs/default", DefaultKeyFlag: "1", CreationDate: "1530403200000"},
+		{KeyID: "4", KeyAlias: "app-broken", DefaultKeyFlag: "0", CreationDate: ""},
+	}
+	mustParse := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	cases := []struct {
+		filter kmsKeysV1Filter
+		ids    []string
+	}{
+		{
+			filter: kmsKeysV1Filter{},
+			ids:    []string{"1", "2", "3", "4"},
+		},
+		{
+			filter: kmsKeysV1Filter{AliasRegex: regexp.MustCompile("^app-")},
+			ids:    []string{"1", "2", "4"},
+		},
+		{
+			filter: kmsKeysV1Filter{DefaultKeyFlag: "1"},
+			ids:    []string{"3"},
+		},
+		{
+			// 2018-06-01T00:00:00Z is the creation date of key 2.
+			filter: kmsKeysV1Filter{CreatedAfter: mustParse("2018-06-01T00:00:00Z")},
+			ids:    []string{"2", "3"},
+		},
+		{
+			filter: kmsKeysV1Filter{CreatedBefore: mustParse("2018-06-01T00:00:00Z")},
+			ids:    []string{"1"},
+		},
+		{
+			filter: kmsKeysV1Filter{
+				AliasRegex:    regexp.MustCompile("^app-"),
+				CreatedAfter:  mustParse("2018-05-15T00:00:00Z"),
+				CreatedBefore: mustParse("2018-07-15T00:00:00Z"),
+			},
+			ids: []string{"2"},
+		},
+	}
+
+	for i, tc := range cases {
+		ids := []string{}
+		for _, key := range filterKmsKeysV1(allKeys, tc.filter) {
+			ids = append(ids, key.KeyID)
+		}
+		if !reflect.DeepEqual(ids, tc.ids) {
+			t.Fatalf("case %d: expected %v, got %v", i, tc.ids, ids)
+		}
+	}
+}
+
+func TestAccKmsKeysV1DataSource_basic(t *testing.T) {
+	keysAlias := fmt.Sprintf("keys_alias_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsKeysV1DataSource_keys(keysAlias),
+			},
+			resource.TestStep{
+				Config: testAccKmsKeysV1DataSource_basic(keysAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_kms_keys_v1.enabled", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.huaweicloud_kms_keys_v1.enabled", "ids.0",
+						"huaweicloud_kms_key_v1.key1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_kms_keys_v1.enabled", "states.0", EnabledState),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_kms_keys_v1.disabled", "ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_kms_keys_v1.disabled", "aliases.0", keysAlias+"_2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsKeysV1DataSource_keys(keysAlias string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key_v1" "key1" {
+  key_alias    = "%s_1"
+  pending_days = "7"
+  is_enabled   = true
+}
+
+resource "huaweicloud_kms_key_v1" "key2" {
+  key_alias    = "%s_2"
+  pending_days = "7"
+  is_enabled   = false
+}
+`, keysAlias, keysAlias)
+}
+
+func testAccKmsKeysV1DataSource_basic(keysAlias string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_kms_keys_v1" "enabled" {
+  key_state       = "2"
+  key_alias_regex = "^%s_"
+}
+
+data "huaweicloud_kms_keys_v1" "disabled" {
+  key_state       = "3"
+  key_alias_regex = "^%s_"
+}
+`, testAccKmsKeysV1DataSource_keys(keysAlias), keysAlias, keysAlias)
+}
@@ -0,0 +1,84 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceKmsSecrets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKmsSecretsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"secret": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"payload": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"context": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"plaintext": &schema.Schema{
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud kms key client: %s", err)
+	}
+
+	plaintext := make(map[string]string)
+	for _, v := range d.Get("secret").(*schema.Set).List() {
+		secret := v.(map[string]interface{})
+		name := secret["name"].(string)
+		if _, ok := plaintext[name]; ok {
+			return fmt.Errorf("Duplicate KMS secret name: %s", name)
+		}
+
+		decryptOpts := KmsDecryptDataOpts{
+			CipherText:        secret["payload"].(string),
+			EncryptionContext: expandKmsEncryptionContext(secret["context"].(map[string]interface{})),
+		}
+
+		log.Printf("[DEBUG] KMS decrypt secret: %s", name)
+		v, err := decryptKmsData(kmsKeyV1Client, decryptOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error decrypting KMS secret %s: %s", name, err)
+		}
+		plaintext[name] = v.PlainText
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("plaintext", plaintext)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
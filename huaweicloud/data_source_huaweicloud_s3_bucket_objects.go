@@ -0,0 +1,156 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// maxS3ObjectsPageSize is the most keys S3 returns in one listing.
+const maxS3ObjectsPageSize = 1000
+
+func dataSourceS3BucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceS3BucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delimiter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_keys": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validateS3BucketObjectsMaxKeys,
+			},
+			"keys": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"common_prefixes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"owners": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	if v, ok := d.GetOk("delimiter"); ok {
+		input.Delimiter = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Listing S3 objects: %s", input)
+	keys, commonPrefixes, owners, err := listS3BucketObjects(conn, input, d.Get("max_keys").(int))
+	if err != nil {
+		return fmt.Errorf("Error listing S3 objects of bucket %q: %s", bucket, err)
+	}
+	log.Printf("[DEBUG] Listed %d keys and %d common prefixes of S3 bucket %q", len(keys), len(commonPrefixes), bucket)
+
+	d.SetId(bucket + "/" + prefix)
+
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("Error setting keys: %s", err)
+	}
+	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
+		return fmt.Errorf("Error setting common_prefixes: %s", err)
+	}
+	if err := d.Set("owners", owners); err != nil {
+		return fmt.Errorf("Error setting owners: %s", err)
+	}
+
+	return nil
+}
+
+// listS3BucketObjects pages through the objects matched by input until
+// maxKeys keys and common prefixes have been collected or there are no
+// more. The owners are returned in the order of the keys.
+func listS3BucketObjects(conn *s3.S3, input *s3.ListObjectsInput, maxKeys int) (keys, commonPrefixes, owners []string, err error) {
+	keys = []string{}
+	commonPrefixes = []string{}
+	owners = []string{}
+
+	remaining := maxKeys
+	for remaining > 0 {
+		pageSize := remaining
+		if pageSize > maxS3ObjectsPageSize {
+			pageSize = maxS3ObjectsPageSize
+		}
+		input.MaxKeys = aws.Int64(int64(pageSize))
+
+		page, err := conn.ListObjects(input)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		for _, prefix := range page.CommonPrefixes {
+			if remaining == 0 {
+				break
+			}
+			commonPrefixes = append(commonPrefixes, aws.StringValue(prefix.Prefix))
+			remaining--
+		}
+		for _, object := range page.Contents {
+			if remaining == 0 {
+				break
+			}
+			keys = append(keys, aws.StringValue(object.Key))
+			if object.Owner != nil {
+				owners = append(owners, aws.StringValue(object.Owner.ID))
+			} else {
+				owners = append(owners, "")
+			}
+			remaining--
+		}
+
+		if !aws.BoolValue(page.IsTruncated) {
+			break
+		}
+		// NextMarker is only returned when a delimiter is given, otherwise
+		// the listing continues after the last key of the page.
+		marker := aws.StringValue(page.NextMarker)
+		if marker == "" && len(page.Contents) > 0 {
+			marker = aws.StringValue(page.Contents[len(page.Contents)-1].Key)
+		}
+		if marker == "" {
+			break
+		}
+		input.Marker = aws.String(marker)
+	}
+
+	return keys, commonPrefixes, owners, nil
+}
@@ -0,0 +1,186 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestListS3BucketObjects(t *testing.T) {
+	// Each page holds two keys. The marker is the last key of the previous page.
+	pages := map[string]string{
+		"": `<Contents><Key>build/1</Key><Owner><ID>owner-1</ID></Owner></Contents>
+<Contents><Key>build/2</Key><Owner><ID>owner-1</ID></Owner></Contents>
+<CommonPrefixes><Prefix>build/logs/</Prefix></CommonPrefixes>
+<IsTruncated>true</IsTruncated>`,
+		"build/2": `<Contents><Key>build/3</Key><Owner><ID>owner-2</ID></Owner></Contents>
+<Contents><Key>build/4</Key><Owner><ID>owner-2</ID></Owner></Contents>
+<IsTruncated>true</IsTruncated>`,
+		"build/4": `<Contents><Key>build/5</Key><Owner><ID>owner-2</ID></Owner></Contents>
+<IsTruncated>false</IsTruncated>`,
+	}
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("prefix") != "build/" {
+			t.Errorf("expected prefix build/, got %q", r.URL.Query().Get("prefix"))
+		}
+		page, ok := pages[r.URL.Query().Get("marker")]
+		if !ok {
+			t.Errorf("unexpected marker %q", r.URL.Query().Get("marker"))
+		}
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><ListBucketResult>%s</ListBucketResult>`, page)
+	}))
+	defer ts.Close()
+
+	conn := s3.New(session.New(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:         aws.String(ts.URL),
+		Region:           aws.String("region"),
+		S3ForcePathStyle: aws.Bool(true),
+	}))
+
+	cases := []struct {
+		maxKeys                      int
+		requests                     int
+		keys, commonPrefixes, owners []string
+	}{
+		{
+			maxKeys:        1000,
+			requests:       3,
+			keys:           []string{"build/1", "build/2", "build/3", "build/4", "build/5"},
+			commonPrefixes: []string{"build/logs/"},
+			owners:         []string{"owner-1", "owner-1", "owner-2", "owner-2", "owner-2"},
+		},
+		{
+			maxKeys:        4,
+			requests:       2,
+			keys:           []string{"build/1", "build/2", "build/3"},
+			commonPrefixes: []string{"build/logs/"},
+			owners:         []string{"owner-1", "owner-1", "owner-2"},
+		},
+		{
+			maxKeys:        0,
+			requests:       0,
+			keys:           []string{},
+			commonPrefixes: []string{},
+			owners:         []string{},
+		},
+	}
+
+	for i, tc := range cases {
+		requests = 0
+		input := &s3.ListObjectsInput{
+			Bucket: aws.String("bucket"),
+			Prefix: aws.String("build/"),
+		}
+		keys, commonPrefixes, owners, err := listS3BucketObjects(conn, input, tc.maxKeys)
+		if err != nil {
+			t.Fatalf("case %d: %s", i, err)
+		}
+		if requests != tc.requests {
+			t.Errorf("case %d: expected %d requests, got %d", i, tc.requests, requests)
+		}
+		if !reflect.DeepEqual(keys, tc.keys) {
+			t.Errorf("case %d: expected keys %v, got %v", i, tc.keys, keys)
+		}
+		if !reflect.DeepEqual(commonPrefixes, tc.commonPrefixes) {
+			t.Errorf("case %d: expected common prefixes %v, got %v", i, tc.commonPrefixes, commonPrefixes)
+		}
+		if !reflect.DeepEqual(owners, tc.owners) {
+			t.Errorf("case %d: expected owners %v, got %v", i, tc.owners, owners)
+		}
+	}
+}
+
+func TestValidateS3BucketObjectsMaxKeys(t *testing.T) {
+	for _, v := range []int{-1, 0} {
+		if _, errors := validateS3BucketObjectsMaxKeys(v, "max_keys"); len(errors) == 0 {
+			t.Errorf("Expected max_keys %d to be invalid", v)
+		}
+	}
+	for _, v := range []int{1, 1000, 5000} {
+		if _, errors := validateS3BucketObjectsMaxKeys(v, "max_keys"); len(errors) != 0 {
+			t.Errorf("Expected max_keys %d to be valid, got %v", v, errors)
+		}
+	}
+}
+
+func TestAccDataSourceS3BucketObjects_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceOnlyConf, conf := testAccDataSourceS3ObjectsConfig_basic(rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: resourceOnlyConf,
+			},
+			resource.TestStep{
+				Config: conf,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.huaweicloud_s3_bucket_objects.all", "keys.#", "3"),
+					resource.TestCheckResourceAttr("data.huaweicloud_s3_bucket_objects.all", "owners.#", "3"),
+					resource.TestCheckResourceAttr("data.huaweicloud_s3_bucket_objects.all", "common_prefixes.#", "0"),
+					resource.TestCheckResourceAttr("data.huaweicloud_s3_bucket_objects.builds", "keys.#", "0"),
+					resource.TestCheckResourceAttr("data.huaweicloud_s3_bucket_objects.builds", "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.huaweicloud_s3_bucket_objects.builds", "common_prefixes.0", "builds/1.0/"),
+					resource.TestCheckResourceAttr("data.huaweicloud_s3_bucket_objects.capped", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.huaweicloud_s3_bucket_objects.capped", "keys.0", "builds/1.0/app.zip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceS3ObjectsConfig_basic(randInt int) (string, string) {
+	resources := fmt.Sprintf(`
+resource "huaweicloud_s3_bucket" "objects_bucket" {
+	bucket = "tf-objects-test-bucket-%d"
+}
+resource "huaweicloud_s3_bucket_object" "object1" {
+	bucket = "${huaweicloud_s3_bucket.objects_bucket.bucket}"
+	key = "builds/1.0/app.zip"
+	content = "1.0"
+}
+resource "huaweicloud_s3_bucket_object" "object2" {
+	bucket = "${huaweicloud_s3_bucket.objects_bucket.bucket}"
+	key = "builds/1.1/app.zip"
+	content = "1.1"
+}
+resource "huaweicloud_s3_bucket_object" "object3" {
+	bucket = "${huaweicloud_s3_bucket.objects_bucket.bucket}"
+	key = "readme.txt"
+	content = "builds"
+}
+`, randInt)
+
+	both := fmt.Sprintf(`%s
+data "huaweicloud_s3_bucket_objects" "all" {
+	bucket = "tf-objects-test-bucket-%d"
+}
+data "huaweicloud_s3_bucket_objects" "builds" {
+	bucket = "tf-objects-test-bucket-%d"
+	prefix = "builds/"
+	delimiter = "/"
+}
+data "huaweicloud_s3_bucket_objects" "capped" {
+	bucket = "tf-objects-test-bucket-%d"
+	prefix = "builds/"
+	max_keys = 1
+}`, resources, randInt, randInt, randInt)
+
+	return resources, both
+}
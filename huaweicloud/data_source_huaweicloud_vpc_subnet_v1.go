@@ -0,0 +1,138 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceVpcSubnetV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVpcSubnetV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"gateway_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"dhcp_enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"primary_dns": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secondary_dns": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_list": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	listOpts := VpcSubnetListOpts{
+		ID:               d.Get("id").(string),
+		Name:             d.Get("name").(string),
+		CIDR:             d.Get("cidr").(string),
+		GatewayIP:        d.Get("gateway_ip").(string),
+		VpcID:            d.Get("vpc_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		Status:           d.Get("status").(string),
+	}
+
+	pages, err := listVpcSubnets(vpcClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve Subnets: %s", err)
+	}
+
+	allSubnets, err := extractVpcSubnets(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract Subnets: %s", err)
+	}
+
+	refinedSubnets := filterVpcSubnets(allSubnets, listOpts)
+
+	if len(refinedSubnets) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedSubnets) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	subnet := refinedSubnets[0]
+
+	log.Printf("[DEBUG] Retrieved Subnet %s: %+v", subnet.ID, subnet)
+	d.SetId(subnet.ID)
+
+	d.Set("name", subnet.Name)
+	d.Set("cidr", subnet.CIDR)
+	d.Set("gateway_ip", subnet.GatewayIP)
+	d.Set("vpc_id", subnet.VpcID)
+	d.Set("availability_zone", subnet.AvailabilityZone)
+	d.Set("status", subnet.Status)
+	d.Set("dhcp_enable", subnet.DhcpEnable)
+	d.Set("primary_dns", subnet.PrimaryDNS)
+	d.Set("secondary_dns", subnet.SecondaryDNS)
+	d.Set("dns_list", subnet.DNSList)
+	d.Set("subnet_id", subnet.NeutronSubnetID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
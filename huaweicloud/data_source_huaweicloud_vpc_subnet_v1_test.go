@@ -0,0 +1,76 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccHuaweiCloudVpcSubnetV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccHuaweiCloudVpcSubnetV1DataSource_subnet,
+			},
+			resource.TestStep{
+				Config: testAccHuaweiCloudVpcSubnetV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcSubnetV1DataSourceID("data.huaweicloud_vpc_subnet_v1.by_cidr"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_vpc_subnet_v1.by_cidr", "name", "tf_test_subnet"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_vpc_subnet_v1.by_cidr", "gateway_ip", "172.16.8.1"),
+					resource.TestCheckResourceAttrPair(
+						"data.huaweicloud_vpc_subnet_v1.by_id", "vpc_id",
+						"huaweicloud_vpc_v1.vpc_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcSubnetV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find subnet data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Subnet data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccHuaweiCloudVpcSubnetV1DataSource_subnet = `
+resource "huaweicloud_vpc_v1" "vpc_1" {
+  name = "tf_test_vpc"
+  cidr = "172.16.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet_v1" "subnet_1" {
+  name = "tf_test_subnet"
+  cidr = "172.16.8.0/24"
+  gateway_ip = "172.16.8.1"
+  vpc_id = "${huaweicloud_vpc_v1.vpc_1.id}"
+}
+`
+
+var testAccHuaweiCloudVpcSubnetV1DataSource_basic = fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpc_subnet_v1" "by_cidr" {
+  vpc_id = "${huaweicloud_vpc_v1.vpc_1.id}"
+  cidr = "${huaweicloud_vpc_subnet_v1.subnet_1.cidr}"
+}
+
+data "huaweicloud_vpc_subnet_v1" "by_id" {
+  id = "${huaweicloud_vpc_subnet_v1.subnet_1.id}"
+}
+`, testAccHuaweiCloudVpcSubnetV1DataSource_subnet)
@@ -0,0 +1,110 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceVpcV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVpcV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nexthop": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVpcV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	listOpts := VpcListOpts{
+		ID:     d.Get("id").(string),
+		Name:   d.Get("name").(string),
+		CIDR:   d.Get("cidr").(string),
+		Status: d.Get("status").(string),
+	}
+
+	pages, err := listVpcs(vpcClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve VPCs: %s", err)
+	}
+
+	allVpcs, err := extractVpcs(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract VPCs: %s", err)
+	}
+
+	refinedVpcs := filterVpcs(allVpcs, listOpts)
+
+	if len(refinedVpcs) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedVpcs) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	vpc := refinedVpcs[0]
+
+	log.Printf("[DEBUG] Retrieved VPC %s: %+v", vpc.ID, vpc)
+	d.SetId(vpc.ID)
+
+	d.Set("name", vpc.Name)
+	d.Set("cidr", vpc.CIDR)
+	d.Set("status", vpc.Status)
+	if err := d.Set("routes", flattenVpcRoutes(vpc.Routes)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving routes to state for VPC (%s): %s", vpc.ID, err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
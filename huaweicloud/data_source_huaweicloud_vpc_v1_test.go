@@ -0,0 +1,66 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccHuaweiCloudVpcV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccHuaweiCloudVpcV1DataSource_vpc,
+			},
+			resource.TestStep{
+				Config: testAccHuaweiCloudVpcV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1DataSourceID("data.huaweicloud_vpc_v1.by_name"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_vpc_v1.by_name", "cidr", "172.16.0.0/16"),
+					resource.TestCheckResourceAttrPair(
+						"data.huaweicloud_vpc_v1.by_id", "name",
+						"huaweicloud_vpc_v1.vpc_1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find VPC data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("VPC data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccHuaweiCloudVpcV1DataSource_vpc = `
+resource "huaweicloud_vpc_v1" "vpc_1" {
+  name = "tf_test_vpc"
+  cidr = "172.16.0.0/16"
+}
+`
+
+var testAccHuaweiCloudVpcV1DataSource_basic = fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpc_v1" "by_name" {
+  name = "${huaweicloud_vpc_v1.vpc_1.name}"
+}
+
+data "huaweicloud_vpc_v1" "by_id" {
+  id = "${huaweicloud_vpc_v1.vpc_1.id}"
+}
+`, testAccHuaweiCloudVpcV1DataSource_vpc)
@@ -31,3 +31,17 @@ func suppressComputedFixedWhenFloatingIp(k, old, new string, d *schema.ResourceD
 	}
 	return false
 }
+
+// Suppress changes between JSON documents which only differ in formatting.
+func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldJson, err := normalizeJsonString(old)
+	if err != nil {
+		return false
+	}
+	newJson, err := normalizeJsonString(new)
+	if err != nil {
+		return false
+	}
+
+	return oldJson == newJson
+}
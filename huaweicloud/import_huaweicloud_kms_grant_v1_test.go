@@ -0,0 +1,32 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKmsGrantV1_importBasic(t *testing.T) {
+	resourceName := "huaweicloud_kms_grant_v1.grant_1"
+	keyAlias := fmt.Sprintf("kms_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKmsGrant(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsGrantV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsGrantV1_basic(keyAlias),
+			},
+
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retire_on_delete"},
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccS3BucketInventory_importBasic(t *testing.T) {
+	resourceName := "huaweicloud_s3_bucket_inventory.inventory"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketInventoryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccS3BucketInventoryConfig(acctest.RandInt()),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVpcV1BandWidth_importBasic(t *testing.T) {
+	resourceName := "huaweicloud_vpc_bandwidth_v1.bandwidth_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcV1BandWidthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcV1BandWidth_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
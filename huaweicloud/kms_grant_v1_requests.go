@@ -0,0 +1,146 @@
+package huaweicloud
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// The grant requests below are missing from the vendored golangsdk kms/v1
+// packages.
+
+const kmsGrantResourcePath = "kms"
+
+// KmsGrant represents a grant, which allows a user or a domain to use a key
+// for the listed operations.
+type KmsGrant struct {
+	KeyID                string   `json:"key_id"`
+	GrantID              string   `json:"grant_id"`
+	GranteePrincipal     string   `json:"grantee_principal"`
+	GranteePrincipalType string   `json:"grantee_principal_type"`
+	Operations           []string `json:"operations"`
+	IssuingPrincipal     string   `json:"issuing_principal"`
+	CreationDate         string   `json:"creation_date"`
+	Name                 string   `json:"name"`
+	RetiringPrincipal    string   `json:"retiring_principal"`
+}
+
+// KmsGrantList represents one page of the grants of a key.
+type KmsGrantList struct {
+	Grants     []KmsGrant `json:"grants"`
+	NextMarker string     `json:"next_marker"`
+	Truncated  string     `json:"truncated"`
+	Total      int        `json:"total"`
+}
+
+type kmsGrantCreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the created grant.
+func (r kmsGrantCreateResult) Extract() (string, error) {
+	var s struct {
+		GrantID string `json:"grant_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.GrantID, err
+}
+
+type kmsGrantListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a kmsGrantListResult as a KmsGrantList.
+func (r kmsGrantListResult) Extract() (*KmsGrantList, error) {
+	var s KmsGrantList
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// KmsGrantCreateOpts represents the attributes used when creating a grant.
+type KmsGrantCreateOpts struct {
+	KeyID                string   `json:"key_id" required:"true"`
+	GranteePrincipal     string   `json:"grantee_principal" required:"true"`
+	Operations           []string `json:"operations" required:"true"`
+	Name                 string   `json:"name,omitempty"`
+	RetiringPrincipal    string   `json:"retiring_principal,omitempty"`
+	GranteePrincipalType string   `json:"grantee_principal_type,omitempty"`
+}
+
+// ToKmsGrantCreateMap casts a KmsGrantCreateOpts struct to a map.
+func (opts KmsGrantCreateOpts) ToKmsGrantCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// KmsGrantDeleteOpts represents the grant to revoke or retire.
+type KmsGrantDeleteOpts struct {
+	KeyID   string `json:"key_id" required:"true"`
+	GrantID string `json:"grant_id" required:"true"`
+}
+
+// ToKmsGrantDeleteMap casts a KmsGrantDeleteOpts struct to a map.
+func (opts KmsGrantDeleteOpts) ToKmsGrantDeleteMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// KmsGrantListOpts represents the options used when listing the grants of a
+// key.
+type KmsGrantListOpts struct {
+	KeyID  string `json:"key_id" required:"true"`
+	Limit  string `json:"limit,omitempty"`
+	Marker string `json:"marker,omitempty"`
+}
+
+// ToKmsGrantListMap casts a KmsGrantListOpts struct to a map.
+func (opts KmsGrantListOpts) ToKmsGrantListMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func createKmsGrant(client *golangsdk.ServiceClient, opts KmsGrantCreateOpts) (r kmsGrantCreateResult) {
+	b, err := opts.ToKmsGrantCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, kmsGrantResourcePath, "create-grant"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// revokeKmsGrant revokes a grant. It is called by the owner of the key.
+func revokeKmsGrant(client *golangsdk.ServiceClient, opts KmsGrantDeleteOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToKmsGrantDeleteMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, kmsGrantResourcePath, "revoke-grant"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// retireKmsGrant retires a grant. It is called by the retiring principal of
+// the grant.
+func retireKmsGrant(client *golangsdk.ServiceClient, opts KmsGrantDeleteOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToKmsGrantDeleteMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, kmsGrantResourcePath, "retire-grant"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func listKmsGrants(client *golangsdk.ServiceClient, opts KmsGrantListOpts) (r kmsGrantListResult) {
+	b, err := opts.ToKmsGrantListMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, kmsGrantResourcePath, "list-grants"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
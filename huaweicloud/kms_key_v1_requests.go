@@ -0,0 +1,102 @@
+package huaweicloud
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/kms/v1/keys"
+)
+
+// The key rotation and deletion cancelling requests below are missing from
+// the vendored golangsdk kms/v1/keys package.
+
+const kmsKeyResourcePath = "kms"
+
+// KmsKeyRotationStatus represents the automatic rotation of a key.
+type KmsKeyRotationStatus struct {
+	Enabled           bool   `json:"key_rotation_enabled"`
+	Interval          int    `json:"rotation_interval"`
+	LastRotationTime  string `json:"last_rotation_time"`
+	NumberOfRotations int    `json:"number_of_rotations"`
+}
+
+type kmsKeyRotationResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a kmsKeyRotationResult as a KmsKeyRotationStatus.
+func (r kmsKeyRotationResult) Extract() (*KmsKeyRotationStatus, error) {
+	var s KmsKeyRotationStatus
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type kmsKeyCancelDeleteResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID and the state of the key whose deletion was
+// cancelled.
+func (r kmsKeyCancelDeleteResult) Extract() (*keys.Key, error) {
+	var s keys.Key
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// KmsKeyRotationOpts represents the key whose rotation is looked up or
+// changed, and the rotation interval in days (30 to 365).
+type KmsKeyRotationOpts struct {
+	KeyID    string `json:"key_id" required:"true"`
+	Interval int    `json:"rotation_interval,omitempty"`
+}
+
+// ToKmsKeyRotationMap casts a KmsKeyRotationOpts struct to a map.
+func (opts KmsKeyRotationOpts) ToKmsKeyRotationMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// cancelKmsKeyDeletion cancels the scheduled deletion of a key. The key is
+// disabled afterwards.
+func cancelKmsKeyDeletion(client *golangsdk.ServiceClient, id string) (r kmsKeyCancelDeleteResult) {
+	b := map[string]interface{}{"key_id": id}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, kmsKeyResourcePath, "cancel-key-deletion"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func getKmsKeyRotation(client *golangsdk.ServiceClient, opts KmsKeyRotationOpts) (r kmsKeyRotationResult) {
+	b, err := opts.ToKmsKeyRotationMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, kmsKeyResourcePath, "get-key-rotation-status"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// postKmsKeyRotation sends a rotation request which only returns a status,
+// such as enable-key-rotation.
+func postKmsKeyRotation(client *golangsdk.ServiceClient, action string, opts KmsKeyRotationOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToKmsKeyRotationMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, kmsKeyResourcePath, action), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func enableKmsKeyRotation(client *golangsdk.ServiceClient, opts KmsKeyRotationOpts) golangsdk.ErrResult {
+	return postKmsKeyRotation(client, "enable-key-rotation", opts)
+}
+
+func disableKmsKeyRotation(client *golangsdk.ServiceClient, opts KmsKeyRotationOpts) golangsdk.ErrResult {
+	return postKmsKeyRotation(client, "disable-key-rotation", opts)
+}
+
+func updateKmsKeyRotationInterval(client *golangsdk.ServiceClient, opts KmsKeyRotationOpts) golangsdk.ErrResult {
+	return postKmsKeyRotation(client, "update-key-rotation-interval", opts)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
@@ -220,20 +221,20 @@ func waitForLBV2viaPool(networkingClient *gophercloud.ServiceClient, id string,
 		return err
 	}
 
-	if pool.Loadbalancers != nil {
+	if len(pool.Loadbalancers) > 0 {
 		// each pool has an LB in Octavia lbaasv2 API
 		lbID := pool.Loadbalancers[0].ID
 		return waitForLBV2LoadBalancer(networkingClient, lbID, target, nil, timeout)
 	}
 
-	if pool.Listeners != nil {
+	if len(pool.Listeners) > 0 {
 		// each pool has a listener in Neutron lbaasv2 API
 		listenerID := pool.Listeners[0].ID
 		listener, err := listeners.Get(networkingClient, listenerID).Extract()
 		if err != nil {
 			return err
 		}
-		if listener.Loadbalancers != nil {
+		if len(listener.Loadbalancers) > 0 {
 			lbID := listener.Loadbalancers[0].ID
 			return waitForLBV2LoadBalancer(networkingClient, lbID, target, nil, timeout)
 		}
@@ -243,6 +244,30 @@ func waitForLBV2viaPool(networkingClient *gophercloud.ServiceClient, id string,
 	return fmt.Errorf("No Load Balancer on pool %s", id)
 }
 
+func waitForLBV2viaListener(networkingClient *gophercloud.ServiceClient, id string, target string, timeout time.Duration) error {
+	listener, err := listeners.Get(networkingClient, id).Extract()
+	if err != nil {
+		return err
+	}
+
+	if len(listener.Loadbalancers) > 0 {
+		lbID := listener.Loadbalancers[0].ID
+		return waitForLBV2LoadBalancer(networkingClient, lbID, target, nil, timeout)
+	}
+
+	// got a listener but no LB - this is wrong
+	return fmt.Errorf("No Load Balancer on listener %s", id)
+}
+
+func waitForLBV2viaL7Policy(networkingClient *gophercloud.ServiceClient, id string, target string, timeout time.Duration) error {
+	l7policy, err := l7policies.Get(networkingClient, id).Extract()
+	if err != nil {
+		return err
+	}
+
+	return waitForLBV2viaListener(networkingClient, l7policy.ListenerID, target, timeout)
+}
+
 func chooseLBV2Client(d *schema.ResourceData, config *Config) (*gophercloud.ServiceClient, error) {
 	if config.useOctavia {
 		return config.loadBalancerV2Client(GetRegion(d, config))
@@ -0,0 +1,183 @@
+package huaweicloud
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	obsHeaderPrefix   = "x-obs-"
+	obsHeaderDate     = "x-obs-date"
+	obsHeaderToken    = "x-obs-security-token"
+	obsHeaderAuthName = "Authorization"
+)
+
+// obsSubResources lists the query parameters which are part of the signed
+// resource. Any other parameter, such as marker or prefix, is not signed.
+var obsSubResources = map[string]bool{
+	"acl":                          true,
+	"cors":                         true,
+	"delete":                       true,
+	"encryption":                   true,
+	"inventory":                    true,
+	"lifecycle":                    true,
+	"location":                     true,
+	"logging":                      true,
+	"notification":                 true,
+	"partNumber":                   true,
+	"policy":                       true,
+	"quota":                        true,
+	"replication":                  true,
+	"response-cache-control":       true,
+	"response-content-disposition": true,
+	"response-content-encoding":    true,
+	"response-content-language":    true,
+	"response-content-type":        true,
+	"response-expires":             true,
+	"restore":                      true,
+	"storageClass":                 true,
+	"storageinfo":                  true,
+	"tagging":                      true,
+	"uploadId":                     true,
+	"uploads":                      true,
+	"versionId":                    true,
+	"versioning":                   true,
+	"versions":                     true,
+	"website":                      true,
+}
+
+// OBSRoundTripper satisfies the http.RoundTripper interface and signs every
+// request with the OBS signature before handing it to Rt. Requests must use
+// path-style URLs, i.e. the bucket is the first segment of the path.
+// SecurityToken is only set for temporary credentials.
+type OBSRoundTripper struct {
+	Rt            http.RoundTripper
+	AccessKey     string
+	SecretKey     string
+	SecurityToken string
+}
+
+// RoundTrip signs a copy of the request and performs the round-trip.
+func (ort *OBSRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	req := new(http.Request)
+	*req = *request
+	req.Header = make(http.Header, len(request.Header)+3)
+	for k, v := range request.Header {
+		req.Header[k] = append([]string(nil), v...)
+	}
+
+	if ort.SecurityToken != "" {
+		req.Header.Set(obsHeaderToken, ort.SecurityToken)
+	}
+
+	signOBSRequest(req, ort.AccessKey, ort.SecretKey, time.Now().UTC())
+
+	return ort.Rt.RoundTrip(req)
+}
+
+// signOBSRequest adds the Date and Authorization headers to req. The
+// Content-MD5 and Content-Type headers, if any, must already be set.
+func signOBSRequest(req *http.Request, accessKey, secretKey string, t time.Time) {
+	req.Header.Del(obsHeaderAuthName)
+	req.Header.Set("Date", t.UTC().Format(http.TimeFormat))
+
+	stringToSign := obsStringToSign(req)
+	signature := obsSignature(secretKey, stringToSign)
+
+	req.Header.Set(obsHeaderAuthName, fmt.Sprintf("OBS %s:%s", accessKey, signature))
+}
+
+// obsStringToSign builds the string which is signed:
+//
+//	Method\nContent-MD5\nContent-Type\nDate\nCanonicalizedHeaders CanonicalizedResource
+//
+// The Date line is empty when the x-obs-date header is set.
+func obsStringToSign(req *http.Request) string {
+	date := req.Header.Get("Date")
+	if req.Header.Get(obsHeaderDate) != "" {
+		date = ""
+	}
+
+	return fmt.Sprintf("%s\n%s\n%s\n%s\n%s%s",
+		req.Method,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		date,
+		obsCanonicalHeaders(req),
+		obsCanonicalResource(req.URL))
+}
+
+// obsCanonicalHeaders lists the x-obs- headers sorted by their lower case
+// name, one per line.
+func obsCanonicalHeaders(req *http.Request) string {
+	headers := make(map[string]string)
+	keys := make([]string, 0, len(req.Header))
+	for k, v := range req.Header {
+		k = strings.ToLower(k)
+		if !strings.HasPrefix(k, obsHeaderPrefix) {
+			continue
+		}
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			values = append(values, strings.TrimSpace(value))
+		}
+		headers[k] = strings.Join(values, ",")
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var canonical string
+	for _, k := range keys {
+		canonical += k + ":" + headers[k] + "\n"
+	}
+
+	return canonical
+}
+
+// obsCanonicalResource is the path of the request, ending with a slash when
+// it only names a bucket, followed by the sorted sub-resources.
+func obsCanonicalResource(u *url.URL) string {
+	resource := u.EscapedPath()
+	if resource == "" {
+		resource = "/"
+	}
+	if strings.Count(resource, "/") == 1 && !strings.HasSuffix(resource, "/") {
+		resource += "/"
+	}
+
+	query := u.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		if obsSubResources[k] {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return resource
+	}
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if v := query.Get(k); v != "" {
+			params = append(params, k+"="+v)
+		} else {
+			params = append(params, k)
+		}
+	}
+
+	return resource + "?" + strings.Join(params, "&")
+}
+
+func obsSignature(secretKey, stringToSign string) string {
+	mac := hmac.New(sha1.New, []byte(secretKey))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
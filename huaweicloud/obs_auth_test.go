@@ -0,0 +1,126 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignOBSRequest_knownSignature(t *testing.T) {
+	req, err := http.NewRequest("PUT", "https://obs.cn-north-1.myhuaweicloud.com/my-bucket?acl&marker=a", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-MD5", "1B2M2Y8AsgTpgAmY7PhCfg==")
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("X-Obs-Acl", "public-read")
+	req.Header.Set("x-obs-storage-class", "WARM")
+	req.Header.Set("User-Agent", "terraform")
+
+	signOBSRequest(req, "access-key", "secret-key", time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC))
+
+	if v := req.Header.Get("Date"); v != "Mon, 01 Oct 2018 12:00:00 GMT" {
+		t.Fatalf("Unexpected Date: %s", v)
+	}
+
+	expected := "OBS access-key:z9OvaNO4wP2oq6a8GLoTh5gUy3E="
+	if v := req.Header.Get("Authorization"); v != expected {
+		t.Fatalf("Unexpected Authorization header:\n got: %s\nwant: %s", v, expected)
+	}
+}
+
+func TestOBSCanonicalResource(t *testing.T) {
+	cases := map[string]string{
+		"https://obs.example.com/":                              "/",
+		"https://obs.example.com/my-bucket":                     "/my-bucket/",
+		"https://obs.example.com/my-bucket/":                    "/my-bucket/",
+		"https://obs.example.com/my-bucket/dir/key":             "/my-bucket/dir/key",
+		"https://obs.example.com/my-bucket?policy":              "/my-bucket/?policy",
+		"https://obs.example.com/my-bucket?prefix=a&marker=b":   "/my-bucket/",
+		"https://obs.example.com/my-bucket?versions&uploads":    "/my-bucket/?uploads&versions",
+		"https://obs.example.com/my-bucket/key?versionId=1&x=2": "/my-bucket/key?versionId=1",
+	}
+
+	for raw, expected := range cases {
+		u, err := url.Parse(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := obsCanonicalResource(u); v != expected {
+			t.Errorf("%s: expected %s, got %s", raw, expected, v)
+		}
+	}
+}
+
+func TestOBSRoundTripper_httptest(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if err := testOBSVerifyRequest(r, "access-key", "secret-key"); err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if v := r.Header.Get("X-Obs-Security-Token"); v != "token" {
+			t.Errorf("Unexpected security token: %s", v)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := http.Client{
+		Transport: &OBSRoundTripper{
+			Rt:            http.DefaultTransport,
+			AccessKey:     "access-key",
+			SecretKey:     "secret-key",
+			SecurityToken: "token",
+		},
+	}
+
+	req, err := http.NewRequest("PUT", ts.URL+"/my-bucket?quota", strings.NewReader("<Quota/>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/xml")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected the signature to be accepted, got status %d", resp.StatusCode)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Fatalf("The original request must not be modified")
+	}
+	if requests != 1 {
+		t.Fatalf("Expected 1 request, got %d", requests)
+	}
+}
+
+// testOBSVerifyRequest checks the OBS signature of a request received by a
+// test server.
+func testOBSVerifyRequest(r *http.Request, accessKey, secretKey string) error {
+	auth := r.Header.Get("Authorization")
+	prefix := "OBS " + accessKey + ":"
+	if !strings.HasPrefix(auth, prefix) {
+		return fmt.Errorf("Unexpected Authorization header: %s", auth)
+	}
+
+	if r.Header.Get("Date") == "" {
+		return fmt.Errorf("Missing Date header")
+	}
+
+	signature := obsSignature(secretKey, obsStringToSign(r))
+	if v := strings.TrimPrefix(auth, prefix); v != signature {
+		return fmt.Errorf("Signature mismatch: got %s, want %s", v, signature)
+	}
+
+	return nil
+}
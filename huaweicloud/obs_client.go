@@ -0,0 +1,350 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+const (
+	obsHeaderACL          = "x-obs-acl"
+	obsHeaderStorageClass = "x-obs-storage-class"
+	obsHeaderLocation     = "x-obs-bucket-location"
+	obsHeaderAZRedundancy = "x-obs-az-redundancy"
+
+	obsMultiAZ = "3az"
+)
+
+// OBSClient performs requests on OBS buckets with path-style URLs. The
+// requests are signed by the transport of HTTPClient, see OBSRoundTripper.
+type OBSClient struct {
+	// Endpoint is the root URL of OBS, ending with a slash.
+	Endpoint   string
+	HTTPClient http.Client
+}
+
+// OBSError is the error returned by OBS. Code is empty for responses without
+// a body, such as the responses to HEAD requests.
+type OBSError struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+	RequestID  string `xml:"RequestId"`
+}
+
+func (e OBSError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("OBS request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("OBS request failed with status %d: %s: %s (request id %s)",
+		e.StatusCode, e.Code, e.Message, e.RequestID)
+}
+
+// isOBSNotFound reports whether err is an OBS error with status 404 and, if
+// codes are given, one of the codes.
+func isOBSNotFound(err error, codes ...string) bool {
+	obsErr, ok := err.(OBSError)
+	if !ok || obsErr.StatusCode != http.StatusNotFound {
+		return false
+	}
+	if len(codes) == 0 {
+		return true
+	}
+	for _, code := range codes {
+		if obsErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// OBSCreateBucketOpts contains the settings of a new bucket. MultiAZ stores
+// the data of the bucket in several availability zones of the region.
+type OBSCreateBucketOpts struct {
+	ACL          string
+	StorageClass string
+	Location     string
+	MultiAZ      bool
+}
+
+// OBSBucket is the metadata of a bucket returned by HeadBucket.
+type OBSBucket struct {
+	Location     string
+	StorageClass string
+	MultiAZ      bool
+}
+
+type obsCreateBucketConfiguration struct {
+	XMLName  xml.Name `xml:"CreateBucketConfiguration"`
+	Location string   `xml:"Location"`
+}
+
+// obsAccessControlPolicy is the ACL of a bucket. A grantee is either a
+// domain, by ID, or a group such as Everyone, by Canned.
+type obsAccessControlPolicy struct {
+	XMLName xml.Name   `xml:"AccessControlPolicy"`
+	Grants  []obsGrant `xml:"AccessControlList>Grant"`
+}
+
+type obsGrant struct {
+	GranteeID     string `xml:"Grantee>ID,omitempty"`
+	GranteeCanned string `xml:"Grantee>Canned,omitempty"`
+	Permission    string `xml:"Permission"`
+	Delivered     bool   `xml:"Delivered,omitempty"`
+}
+
+type obsStorageClass struct {
+	XMLName      xml.Name `xml:"StorageClass"`
+	StorageClass string   `xml:",chardata"`
+}
+
+type obsQuota struct {
+	XMLName      xml.Name `xml:"Quota"`
+	StorageQuota int64    `xml:"StorageQuota"`
+}
+
+// CreateBucket creates a bucket.
+func (c *OBSClient) CreateBucket(bucket string, opts OBSCreateBucketOpts) error {
+	header := make(http.Header)
+	if opts.ACL != "" {
+		header.Set(obsHeaderACL, opts.ACL)
+	}
+	if opts.StorageClass != "" {
+		header.Set(obsHeaderStorageClass, opts.StorageClass)
+	}
+	if opts.MultiAZ {
+		header.Set(obsHeaderAZRedundancy, obsMultiAZ)
+	}
+
+	var body interface{}
+	if opts.Location != "" {
+		body = obsCreateBucketConfiguration{Location: opts.Location}
+	}
+
+	_, err := c.doXML("PUT", bucket, "", header, body, nil)
+	return err
+}
+
+// HeadBucket returns the metadata of a bucket.
+func (c *OBSClient) HeadBucket(bucket string) (*OBSBucket, error) {
+	header, err := c.doXML("HEAD", bucket, "", nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	storageClass := header.Get(obsHeaderStorageClass)
+	if storageClass == "" {
+		storageClass = "STANDARD"
+	}
+
+	return &OBSBucket{
+		Location:     header.Get(obsHeaderLocation),
+		StorageClass: storageClass,
+		MultiAZ:      header.Get(obsHeaderAZRedundancy) == obsMultiAZ,
+	}, nil
+}
+
+// DeleteBucket deletes an empty bucket.
+func (c *OBSClient) DeleteBucket(bucket string) error {
+	_, err := c.doXML("DELETE", bucket, "", nil, nil, nil)
+	return err
+}
+
+// SetBucketACL applies a canned ACL such as private or public-read.
+func (c *OBSClient) SetBucketACL(bucket, acl string) error {
+	header := make(http.Header)
+	header.Set(obsHeaderACL, acl)
+
+	_, err := c.doXML("PUT", bucket, "acl", header, nil, nil)
+	return err
+}
+
+// GetBucketACL returns the canned ACL matching the permissions that the ACL
+// of a bucket grants to everyone. Grants to single domains are ignored.
+func (c *OBSClient) GetBucketACL(bucket string) (string, error) {
+	var policy obsAccessControlPolicy
+	if _, err := c.doXML("GET", bucket, "acl", nil, nil, &policy); err != nil {
+		return "", err
+	}
+
+	var read, write, delivered bool
+	for _, grant := range policy.Grants {
+		if grant.GranteeCanned != "Everyone" {
+			continue
+		}
+		switch grant.Permission {
+		case "READ":
+			read = true
+		case "WRITE":
+			write = true
+		case "FULL_CONTROL":
+			read, write = true, true
+		default:
+			continue
+		}
+		delivered = delivered || grant.Delivered
+	}
+
+	acl := "private"
+	switch {
+	case read && write:
+		acl = "public-read-write"
+	case read:
+		acl = "public-read"
+	}
+	if acl != "private" && delivered {
+		acl += "-delivered"
+	}
+
+	return acl, nil
+}
+
+// SetBucketStorageClass changes the default storage class of a bucket.
+func (c *OBSClient) SetBucketStorageClass(bucket, storageClass string) error {
+	_, err := c.doXML("PUT", bucket, "storageClass", nil, obsStorageClass{StorageClass: storageClass}, nil)
+	return err
+}
+
+// GetBucketQuota returns the quota of a bucket in bytes, 0 meaning no quota.
+func (c *OBSClient) GetBucketQuota(bucket string) (int64, error) {
+	var quota obsQuota
+	_, err := c.doXML("GET", bucket, "quota", nil, nil, &quota)
+	return quota.StorageQuota, err
+}
+
+// SetBucketQuota sets the quota of a bucket in bytes, 0 meaning no quota.
+func (c *OBSClient) SetBucketQuota(bucket string, quota int64) error {
+	_, err := c.doXML("PUT", bucket, "quota", nil, obsQuota{StorageQuota: quota}, nil)
+	return err
+}
+
+// GetBucketPolicy returns the policy of a bucket, or an empty string when the
+// bucket has no policy.
+func (c *OBSClient) GetBucketPolicy(bucket string) (string, error) {
+	resp, err := c.do("GET", bucket, "policy", nil, nil)
+	if err != nil {
+		if isOBSNotFound(err, "NoSuchBucketPolicy") {
+			return "", nil
+		}
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	policy, err := ioutil.ReadAll(resp.Body)
+	return string(policy), err
+}
+
+// SetBucketPolicy sets the policy of a bucket, a JSON document.
+func (c *OBSClient) SetBucketPolicy(bucket, policy string) error {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	resp, err := c.do("PUT", bucket, "policy", header, []byte(policy))
+	if err != nil {
+		return err
+	}
+	return obsCloseResponse(resp)
+}
+
+// DeleteBucketPolicy removes the policy of a bucket.
+func (c *OBSClient) DeleteBucketPolicy(bucket string) error {
+	_, err := c.doXML("DELETE", bucket, "policy", nil, nil, nil)
+	return err
+}
+
+// BucketDomainName returns the virtual-hosted style domain name of a bucket.
+func (c *OBSClient) BucketDomainName(bucket string) string {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return ""
+	}
+	return bucket + "." + u.Host
+}
+
+// doXML performs a request with an optional XML body and decodes the XML
+// response into out if it is not nil.
+func (c *OBSClient) doXML(method, bucket, subResource string, header http.Header, in, out interface{}) (http.Header, error) {
+	var body []byte
+	if in != nil {
+		var err error
+		body, err = xml.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		if header == nil {
+			header = make(http.Header)
+		}
+		header.Set("Content-Type", "application/xml")
+	}
+
+	resp, err := c.do(method, bucket, subResource, header, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		if err := xml.NewDecoder(resp.Body).Decode(out); err != nil {
+			return nil, fmt.Errorf("Error decoding OBS response: %s", err)
+		}
+	}
+
+	return resp.Header, nil
+}
+
+// do performs a request on bucket, or on sub-resource of the bucket such as
+// acl or policy, and turns error responses into an OBSError.
+func (c *OBSClient) do(method, bucket, subResource string, header http.Header, body []byte) (*http.Response, error) {
+	u := c.Endpoint + url.PathEscape(bucket)
+	if subResource != "" {
+		u += "?" + subResource
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		sum := md5.Sum(body)
+		req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+	}
+
+	log.Printf("[DEBUG] OBS request: %s %s", method, u)
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+
+		obsErr := OBSError{}
+		if data, err := ioutil.ReadAll(resp.Body); err == nil && len(data) > 0 {
+			xml.Unmarshal(data, &obsErr)
+		}
+		obsErr.StatusCode = resp.StatusCode
+		return nil, obsErr
+	}
+
+	return resp, nil
+}
+
+func obsCloseResponse(resp *http.Response) error {
+	_, err := io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	return err
+}
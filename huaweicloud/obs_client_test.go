@@ -0,0 +1,42 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOBSClient_getBucketACL(t *testing.T) {
+	const owner = `<Grant><Grantee><ID>domainiddomainiddomainiddomain0</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>`
+	cases := map[string]string{
+		"private":                     owner,
+		"public-read":                 owner + `<Grant><Grantee><Canned>Everyone</Canned></Grantee><Permission>READ</Permission></Grant>`,
+		"public-read-write":           owner + `<Grant><Grantee><Canned>Everyone</Canned></Grantee><Permission>READ</Permission></Grant><Grant><Grantee><Canned>Everyone</Canned></Grantee><Permission>WRITE</Permission></Grant>`,
+		"public-read-delivered":       owner + `<Grant><Grantee><Canned>Everyone</Canned></Grantee><Permission>READ</Permission><Delivered>true</Delivered></Grant>`,
+		"public-read-write-delivered": owner + `<Grant><Grantee><Canned>Everyone</Canned></Grantee><Permission>FULL_CONTROL</Permission><Delivered>true</Delivered></Grant>`,
+	}
+
+	for expected, grants := range cases {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "GET" || r.URL.RawQuery != "acl" {
+				t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+			}
+			w.Header().Set("Content-Type", "application/xml")
+			fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<AccessControlPolicy xmlns="http://obs.myhwclouds.com/doc/2015-06-30/"><Owner><ID>domainiddomainiddomainiddomain0</ID></Owner><AccessControlList>%s</AccessControlList></AccessControlPolicy>`, grants)
+		}))
+
+		client := OBSClient{Endpoint: ts.URL + "/"}
+		acl, err := client.GetBucketACL("my-bucket")
+		ts.Close()
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", expected, err)
+			continue
+		}
+		if acl != expected {
+			t.Errorf("Expected %s, got %s", expected, acl)
+		}
+	}
+}
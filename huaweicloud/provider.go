@@ -1,6 +1,10 @@
 package huaweicloud
 
 import (
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -27,6 +31,34 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["secret_key"],
 			},
 
+			"security_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_SECURITY_TOKEN", ""),
+				Description: descriptions["security_token"],
+			},
+
+			"assume_role": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agency_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions["assume_role_agency_name"],
+						},
+						"domain_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions["assume_role_domain_name"],
+						},
+					},
+				},
+			},
+
 			"auth_url": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -116,7 +148,7 @@ func Provider() terraform.ResourceProvider {
 			"insecure": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("OS_INSECURE", ""),
+				DefaultFunc: schema.EnvDefaultFunc("OS_INSECURE", nil),
 				Description: descriptions["insecure"],
 			},
 
@@ -161,6 +193,43 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["use_octavia"],
 			},
 
+			"endpoints": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["endpoints"],
+			},
+
+			"max_retries": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				Description:  descriptions["max_retries"],
+				ValidateFunc: validateMaxRetries,
+			},
+
+			"retry_max_wait": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				Description:  descriptions["retry_max_wait"],
+				ValidateFunc: validateRetryMaxWait,
+			},
+
+			"max_requests_per_second": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: descriptions["max_requests_per_second"],
+			},
+
+			"rate_limits": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: descriptions["rate_limits"],
+			},
+
 			"cloud": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -175,55 +244,79 @@ func Provider() terraform.ResourceProvider {
 			"huaweicloud_networking_subnet_v2":   dataSourceNetworkingSubnetV2(),
 			"huaweicloud_networking_secgroup_v2": dataSourceNetworkingSecGroupV2(),
 			"huaweicloud_s3_bucket_object":       dataSourceS3BucketObject(),
+			"huaweicloud_s3_bucket_objects":      dataSourceS3BucketObjects(),
 			"huaweicloud_kms_key_v1":             dataSourceKmsKeyV1(),
+			"huaweicloud_kms_keys_v1":            dataSourceKmsKeysV1(),
+			"huaweicloud_kms_secrets":            dataSourceKmsSecrets(),
 			"huaweicloud_kms_data_key_v1":        dataSourceKmsDataKeyV1(),
 			"huaweicloud_rds_flavors_v1":         dataSourceRdsFlavorV1(),
+			"huaweicloud_as_group_instances":     dataSourceASGroupInstances(),
+			"huaweicloud_vpc_v1":                 dataSourceVpcV1(),
+			"huaweicloud_vpc_subnet_v1":          dataSourceVpcSubnetV1(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"huaweicloud_blockstorage_volume_v2":          resourceBlockStorageVolumeV2(),
-			"huaweicloud_compute_instance_v2":             resourceComputeInstanceV2(),
-			"huaweicloud_compute_keypair_v2":              resourceComputeKeypairV2(),
-			"huaweicloud_compute_secgroup_v2":             resourceComputeSecGroupV2(),
-			"huaweicloud_compute_servergroup_v2":          resourceComputeServerGroupV2(),
-			"huaweicloud_compute_floatingip_v2":           resourceComputeFloatingIPV2(),
-			"huaweicloud_compute_floatingip_associate_v2": resourceComputeFloatingIPAssociateV2(),
-			"huaweicloud_compute_volume_attach_v2":        resourceComputeVolumeAttachV2(),
-			"huaweicloud_dns_recordset_v2":                resourceDNSRecordSetV2(),
-			"huaweicloud_dns_zone_v2":                     resourceDNSZoneV2(),
-			"huaweicloud_fw_firewall_group_v2":            resourceFWFirewallGroupV2(),
-			"huaweicloud_fw_policy_v2":                    resourceFWPolicyV2(),
-			"huaweicloud_fw_rule_v2":                      resourceFWRuleV2(),
-			"huaweicloud_images_image_v2":                 resourceImagesImageV2(),
-			"huaweicloud_kms_key_v1":                      resourceKmsKeyV1(),
-			"huaweicloud_elb_loadbalancer":                resourceELBLoadBalancer(),
-			"huaweicloud_elb_listener":                    resourceELBListener(),
-			"huaweicloud_elb_healthcheck":                 resourceELBHealthCheck(),
-			"huaweicloud_elb_backendecs":                  resourceELBBackendECS(),
-			"huaweicloud_lb_loadbalancer_v2":              resourceLoadBalancerV2(),
-			"huaweicloud_lb_listener_v2":                  resourceListenerV2(),
-			"huaweicloud_lb_pool_v2":                      resourcePoolV2(),
-			"huaweicloud_lb_member_v2":                    resourceMemberV2(),
-			"huaweicloud_lb_monitor_v2":                   resourceMonitorV2(),
-			"huaweicloud_networking_network_v2":           resourceNetworkingNetworkV2(),
-			"huaweicloud_networking_subnet_v2":            resourceNetworkingSubnetV2(),
-			"huaweicloud_networking_floatingip_v2":        resourceNetworkingFloatingIPV2(),
-			"huaweicloud_networking_port_v2":              resourceNetworkingPortV2(),
-			"huaweicloud_networking_router_v2":            resourceNetworkingRouterV2(),
-			"huaweicloud_networking_router_interface_v2":  resourceNetworkingRouterInterfaceV2(),
-			"huaweicloud_networking_router_route_v2":      resourceNetworkingRouterRouteV2(),
-			"huaweicloud_networking_secgroup_v2":          resourceNetworkingSecGroupV2(),
-			"huaweicloud_networking_secgroup_rule_v2":     resourceNetworkingSecGroupRuleV2(),
-			"huaweicloud_s3_bucket":                       resourceS3Bucket(),
-			"huaweicloud_s3_bucket_policy":                resourceS3BucketPolicy(),
-			"huaweicloud_s3_bucket_object":                resourceS3BucketObject(),
-			"huaweicloud_smn_topic_v2":                    resourceTopic(),
-			"huaweicloud_smn_subscription_v2":             resourceSubscription(),
-			"huaweicloud_rds_instance_v1":                 resourceRdsInstance(),
-			"huaweicloud_nat_gateway_v2":                  resourceNatGatewayV2(),
-			"huaweicloud_nat_snat_rule_v2":                resourceNatSnatRuleV2(),
-			"huaweicloud_ces_alarmrule":                   resourceAlarmRule(),
-			"huaweicloud_vpc_eip_v1":                      resourceVpcEIPV1(),
+			"huaweicloud_blockstorage_volume_v2":             resourceBlockStorageVolumeV2(),
+			"huaweicloud_compute_instance_v2":                resourceComputeInstanceV2(),
+			"huaweicloud_compute_keypair_v2":                 resourceComputeKeypairV2(),
+			"huaweicloud_compute_secgroup_v2":                resourceComputeSecGroupV2(),
+			"huaweicloud_compute_servergroup_v2":             resourceComputeServerGroupV2(),
+			"huaweicloud_compute_floatingip_v2":              resourceComputeFloatingIPV2(),
+			"huaweicloud_compute_floatingip_associate_v2":    resourceComputeFloatingIPAssociateV2(),
+			"huaweicloud_compute_volume_attach_v2":           resourceComputeVolumeAttachV2(),
+			"huaweicloud_dns_recordset_v2":                   resourceDNSRecordSetV2(),
+			"huaweicloud_dns_zone_v2":                        resourceDNSZoneV2(),
+			"huaweicloud_fw_firewall_group_v2":               resourceFWFirewallGroupV2(),
+			"huaweicloud_fw_policy_v2":                       resourceFWPolicyV2(),
+			"huaweicloud_fw_rule_v2":                         resourceFWRuleV2(),
+			"huaweicloud_images_image_v2":                    resourceImagesImageV2(),
+			"huaweicloud_kms_key_v1":                         resourceKmsKeyV1(),
+			"huaweicloud_kms_grant_v1":                       resourceKmsGrantV1(),
+			"huaweicloud_kms_ciphertext":                     resourceKmsCiphertext(),
+			"huaweicloud_elb_loadbalancer":                   resourceELBLoadBalancer(),
+			"huaweicloud_elb_listener":                       resourceELBListener(),
+			"huaweicloud_elb_healthcheck":                    resourceELBHealthCheck(),
+			"huaweicloud_elb_backendecs":                     resourceELBBackendECS(),
+			"huaweicloud_lb_loadbalancer_v2":                 resourceLoadBalancerV2(),
+			"huaweicloud_lb_listener_v2":                     resourceListenerV2(),
+			"huaweicloud_lb_pool_v2":                         resourcePoolV2(),
+			"huaweicloud_lb_member_v2":                       resourceMemberV2(),
+			"huaweicloud_lb_monitor_v2":                      resourceMonitorV2(),
+			"huaweicloud_lb_l7policy_v2":                     resourceL7PolicyV2(),
+			"huaweicloud_lb_l7rule_v2":                       resourceL7RuleV2(),
+			"huaweicloud_networking_network_v2":              resourceNetworkingNetworkV2(),
+			"huaweicloud_networking_subnet_v2":               resourceNetworkingSubnetV2(),
+			"huaweicloud_networking_floatingip_v2":           resourceNetworkingFloatingIPV2(),
+			"huaweicloud_networking_port_v2":                 resourceNetworkingPortV2(),
+			"huaweicloud_networking_router_v2":               resourceNetworkingRouterV2(),
+			"huaweicloud_networking_router_interface_v2":     resourceNetworkingRouterInterfaceV2(),
+			"huaweicloud_networking_router_route_v2":         resourceNetworkingRouterRouteV2(),
+			"huaweicloud_networking_secgroup_v2":             resourceNetworkingSecGroupV2(),
+			"huaweicloud_networking_secgroup_rule_v2":        resourceNetworkingSecGroupRuleV2(),
+			"huaweicloud_s3_bucket":                          resourceS3Bucket(),
+			"huaweicloud_s3_bucket_policy":                   resourceS3BucketPolicy(),
+			"huaweicloud_s3_bucket_object":                   resourceS3BucketObject(),
+			"huaweicloud_s3_bucket_notification":             resourceS3BucketNotification(),
+			"huaweicloud_s3_bucket_inventory":                resourceS3BucketInventory(),
+			"huaweicloud_smn_topic_v2":                       resourceTopic(),
+			"huaweicloud_smn_subscription_v2":                resourceSubscription(),
+			"huaweicloud_rds_instance_v1":                    resourceRdsInstance(),
+			"huaweicloud_nat_gateway_v2":                     resourceNatGatewayV2(),
+			"huaweicloud_nat_snat_rule_v2":                   resourceNatSnatRuleV2(),
+			"huaweicloud_ces_alarmrule":                      resourceAlarmRule(),
+			"huaweicloud_vpc_eip_v1":                         resourceVpcEIPV1(),
+			"huaweicloud_as_group_v1":                        resourceASGroup(),
+			"huaweicloud_as_configuration_v1":                resourceASConfiguration(),
+			"huaweicloud_as_policy_v1":                       resourceASPolicy(),
+			"huaweicloud_as_group_instance_attachment":       resourceASGroupInstanceAttachment(),
+			"huaweicloud_vpc_bandwidth_v1":                   resourceVpcBandWidthV1(),
+			"huaweicloud_nat_dnat_rule_v2":                   resourceNatDnatRuleV2(),
+			"huaweicloud_vpc_v1":                             resourceVpcV1(),
+			"huaweicloud_vpc_subnet_v1":                      resourceVpcSubnetV1(),
+			"huaweicloud_vpc_peering_connection_v2":          resourceVpcPeeringConnectionV2(),
+			"huaweicloud_vpc_peering_connection_accepter_v2": resourceVpcPeeringConnectionAccepterV2(),
+			"huaweicloud_vpc_route_table":                    resourceVpcRouteTable(),
+			"huaweicloud_obs_bucket":                         resourceObsBucket(),
 		},
 
 		ConfigureFunc: configureProvider,
@@ -234,6 +327,17 @@ var descriptions map[string]string
 
 func init() {
 	descriptions = map[string]string{
+		"access_key": "The access key of the HuaweiCloud to use.\n" +
+			"Without password or token, requests are signed with the access/secret key pair.",
+
+		"secret_key": "The secret key of the HuaweiCloud to use.",
+
+		"security_token": "The security token to use along with temporary access/secret keys.",
+
+		"assume_role_agency_name": "The name of the agency to assume.",
+
+		"assume_role_domain_name": "The name of the domain that created the agency to assume.",
+
 		"auth_url": "The Identity authentication URL.",
 
 		"region": "The HuaweiCloud region to connect to.",
@@ -273,6 +377,16 @@ func init() {
 			"service (Octavia) instead of the Networking service (Neutron).",
 
 		"cloud": "An entry in a `clouds.yaml` file to use.",
+
+		"endpoints": "The root URLs of services to use instead of looking them up in the catalog.",
+
+		"max_retries": "The maximum number of times a throttled or failed request is retried.",
+
+		"retry_max_wait": "The maximum number of seconds to wait between two retries.",
+
+		"max_requests_per_second": "The maximum number of requests per second sent to each service. 0 means unlimited.",
+
+		"rate_limits": "The maximum number of requests per second sent to a service, by service name.",
 	}
 }
 
@@ -280,6 +394,7 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
 		AccessKey:        d.Get("access_key").(string),
 		SecretKey:        d.Get("secret_key").(string),
+		SecurityToken:    d.Get("security_token").(string),
 		CACertFile:       d.Get("cacert_file").(string),
 		ClientCertFile:   d.Get("cert").(string),
 		ClientKeyFile:    d.Get("key").(string),
@@ -298,6 +413,34 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 		Username:         d.Get("user_name").(string),
 		UserID:           d.Get("user_id").(string),
 		useOctavia:       d.Get("use_octavia").(bool),
+		MaxRetries:       d.Get("max_retries").(int),
+		RetryMaxWait:     time.Duration(d.Get("retry_max_wait").(int)) * time.Second,
+
+		MaxRequestsPerSecond: d.Get("max_requests_per_second").(int),
+	}
+
+	// An explicit insecure = false must win over verify: false in clouds.yaml.
+	_, config.insecureSet = d.GetOkExists("insecure")
+
+	if v, ok := d.GetOk("endpoints"); ok {
+		config.Endpoints = make(map[string]string)
+		for service, endpoint := range v.(map[string]interface{}) {
+			config.Endpoints[service] = endpoint.(string)
+		}
+	}
+
+	if v, ok := d.GetOk("rate_limits"); ok {
+		rateLimits, err := expandRateLimits(v.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok {
+		assumeRole := v.([]interface{})[0].(map[string]interface{})
+		config.AgencyName = assumeRole["agency_name"].(string)
+		config.AgencyDomainName = assumeRole["domain_name"].(string)
 	}
 
 	if err := config.LoadAndValidate(); err != nil {
@@ -306,3 +449,18 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 
 	return &config, nil
 }
+
+// expandRateLimits converts the rate_limits map, whose values may be read
+// back as strings, to requests per second.
+func expandRateLimits(v map[string]interface{}) (map[string]int, error) {
+	rateLimits := make(map[string]int, len(v))
+	for service, rate := range v {
+		n, err := strconv.Atoi(fmt.Sprint(rate))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid rate limit %q for %s: %s", rate, service, err)
+		}
+		rateLimits[service] = n
+	}
+
+	return rateLimits, nil
+}
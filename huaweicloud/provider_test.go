@@ -29,6 +29,9 @@ var (
 	OS_VPC_ID                 = os.Getenv("OS_VPC_ID")
 	OS_TENANT_ID              = os.Getenv("OS_TENANT_ID")
 	OS_ULB_ENVIRONMENT        = os.Getenv("OS_ULB_ENVIRONMENT")
+	OS_AGENCY_NAME            = os.Getenv("OS_AGENCY_NAME")
+	OS_DEST_REGION_NAME       = os.Getenv("OS_DEST_REGION_NAME")
+	OS_GRANTEE_USER_ID        = os.Getenv("OS_GRANTEE_USER_ID")
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -122,6 +125,22 @@ func testAccPreCheckULB(t *testing.T) {
 	}
 }
 
+func testAccPreCheckS3Replication(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_AGENCY_NAME == "" || OS_DEST_REGION_NAME == "" {
+		t.Skip("OS_AGENCY_NAME and OS_DEST_REGION_NAME must be set for S3 replication tests")
+	}
+}
+
+func testAccPreCheckKmsGrant(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_GRANTEE_USER_ID == "" {
+		t.Skip("OS_GRANTEE_USER_ID must be set for KMS grant tests")
+	}
+}
+
 func testAccPreCheckELB(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -0,0 +1,141 @@
+package huaweicloud
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitRoundTripper satisfies the http.RoundTripper interface and waits
+// for the Limiter to allow every request before handing it to Rt.
+type RateLimitRoundTripper struct {
+	Rt      http.RoundTripper
+	Limiter *rateLimiter
+}
+
+// RoundTrip waits for a token of the request host, then performs the
+// round-trip.
+func (rlrt *RateLimitRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if wait := rlrt.Limiter.reserve(request.URL.Host, time.Now()); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-request.Context().Done():
+			timer.Stop()
+			return nil, request.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	return rlrt.Rt.RoundTrip(request)
+}
+
+// rateLimiter keeps one token bucket per host. It is shared by the transports
+// of all the clients of a provider so that their requests count against the
+// same limits.
+type rateLimiter struct {
+	// Rate is the number of requests per second allowed for every host
+	// whose service has no rate of its own. Zero means unlimited.
+	Rate int
+	// ServiceRates maps service names to their number of requests per
+	// second, overriding Rate.
+	ServiceRates map[string]int
+	// ServiceHosts maps hosts to service names, for the hosts which do not
+	// start with the name of their service, e.g. endpoint overrides.
+	ServiceHosts map[string]string
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// reserve takes a token from the bucket of host and returns how long the
+// request must wait before it is sent.
+func (rl *rateLimiter) reserve(host string, now time.Time) time.Duration {
+	if rl == nil {
+		return 0
+	}
+
+	rl.mu.Lock()
+	bucket, ok := rl.buckets[host]
+	if !ok {
+		if rate := rl.hostRate(host); rate > 0 {
+			bucket = newTokenBucket(rate, now)
+		}
+		if rl.buckets == nil {
+			rl.buckets = make(map[string]*tokenBucket)
+		}
+		rl.buckets[host] = bucket
+	}
+	rl.mu.Unlock()
+
+	if bucket == nil {
+		return 0
+	}
+
+	return bucket.reserve(now)
+}
+
+func (rl *rateLimiter) hostRate(host string) int {
+	service := hostService(host)
+	if s, ok := rl.ServiceHosts[host]; ok {
+		service = s
+	}
+
+	if rate, ok := rl.ServiceRates[service]; ok {
+		return rate
+	}
+
+	return rl.Rate
+}
+
+// hostService returns the service name of a host such as
+// ecs.cn-north-1.myhuaweicloud.com, i.e. its first label.
+func hostService(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	return strings.SplitN(host, ".", 2)[0]
+}
+
+// tokenBucket allows rate requests per second, with bursts of up to rate
+// requests.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   float64(rate),
+		burst:  float64(rate),
+		tokens: float64(rate),
+		last:   now,
+	}
+}
+
+// reserve takes a token and returns how long to wait until it is available.
+// The bucket may go negative, which queues the callers in turn.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
@@ -0,0 +1,181 @@
+package huaweicloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestTokenBucket_reserve(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(2, now)
+
+	cases := []struct {
+		Elapsed time.Duration
+		Wait    time.Duration
+	}{
+		// The burst is served right away.
+		{0, 0},
+		{0, 0},
+		// Then the requests are queued one after the other.
+		{0, 500 * time.Millisecond},
+		{0, 1000 * time.Millisecond},
+		// Waiting refills the bucket.
+		{2 * time.Second, 0},
+		{2 * time.Second, 0},
+		{2 * time.Second, 500 * time.Millisecond},
+	}
+
+	for i, tc := range cases {
+		wait := bucket.reserve(now.Add(tc.Elapsed))
+		if wait != tc.Wait {
+			t.Errorf("request %d: expected a wait of %s, got %s", i, tc.Wait, wait)
+		}
+	}
+}
+
+func TestRateLimiter_hostRate(t *testing.T) {
+	limiter := &rateLimiter{
+		Rate:         10,
+		ServiceRates: map[string]int{"ecs": 2, "vpc": 0, "kms": 5},
+		ServiceHosts: map[string]string{"keys.example.com": "kms"},
+	}
+
+	cases := []struct {
+		Host string
+		Rate int
+	}{
+		{"ecs.cn-north-1.myhuaweicloud.com", 2},
+		{"ecs.cn-north-1.myhuaweicloud.com:443", 2},
+		{"vpc.cn-north-1.myhuaweicloud.com", 0},
+		{"evs.cn-north-1.myhuaweicloud.com", 10},
+		{"keys.example.com", 5},
+	}
+
+	for _, tc := range cases {
+		if rate := limiter.hostRate(tc.Host); rate != tc.Rate {
+			t.Errorf("%s: expected a rate of %d, got %d", tc.Host, tc.Rate, rate)
+		}
+	}
+}
+
+func TestRateLimiter_perHost(t *testing.T) {
+	now := time.Now()
+	limiter := &rateLimiter{
+		ServiceRates: map[string]int{"ecs": 1},
+	}
+
+	if wait := limiter.reserve("ecs.example.com", now); wait != 0 {
+		t.Errorf("expected the first ecs request not to wait, got %s", wait)
+	}
+	if wait := limiter.reserve("ecs.example.com", now); wait != time.Second {
+		t.Errorf("expected the second ecs request to wait 1s, got %s", wait)
+	}
+	for i := 0; i < 10; i++ {
+		if wait := limiter.reserve("vpc.example.com", now); wait != 0 {
+			t.Errorf("expected vpc requests to be unlimited, got a wait of %s", wait)
+		}
+	}
+
+	var nilLimiter *rateLimiter
+	if wait := nilLimiter.reserve("ecs.example.com", now); wait != 0 {
+		t.Errorf("expected a nil limiter not to wait, got %s", wait)
+	}
+}
+
+func TestRateLimitRoundTripper_server(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	client := &http.Client{
+		Transport: &RateLimitRoundTripper{
+			Rt: http.DefaultTransport,
+			Limiter: &rateLimiter{
+				Rate:         100,
+				ServiceRates: map[string]int{"ecs": 10},
+				ServiceHosts: map[string]string{u.Host: "ecs"},
+			},
+		},
+	}
+
+	start := time.Now()
+	for i := 0; i < 13; i++ {
+		resp, err := client.Get(ts.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	// 10 requests of burst, then 3 more at 10 per second.
+	if elapsed := time.Since(start); elapsed < 250*time.Millisecond {
+		t.Errorf("expected the requests to be throttled, took %s", elapsed)
+	}
+	if calls != 13 {
+		t.Errorf("expected 13 calls, got %d", calls)
+	}
+}
+
+func TestConfig_rateLimitsInvalid(t *testing.T) {
+	cases := []Config{
+		{RateLimits: map[string]int{"compute": 5}},
+		{RateLimits: map[string]int{"ecs": -1}},
+		{MaxRequestsPerSecond: -1},
+	}
+
+	for _, config := range cases {
+		if err := config.LoadAndValidate(); err == nil {
+			t.Errorf("expected an error for %#v", config)
+		}
+	}
+}
+
+func TestConfig_newRateLimiter(t *testing.T) {
+	config := Config{
+		MaxRequestsPerSecond: 20,
+		RateLimits:           map[string]int{"kms": 3},
+		Endpoints:            map[string]string{"kms": "https://keys.example.com:8443/kms"},
+	}
+
+	limiter := config.newRateLimiter()
+	if limiter.Rate != 20 {
+		t.Errorf("expected a rate of 20, got %d", limiter.Rate)
+	}
+	if rate := limiter.hostRate("keys.example.com:8443"); rate != 3 {
+		t.Errorf("expected the kms override host to have a rate of 3, got %d", rate)
+	}
+}
+
+func TestProvider_rateLimits(t *testing.T) {
+	p := Provider().(*schema.Provider)
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"max_requests_per_second": 20,
+		"rate_limits": map[string]interface{}{
+			"ecs": 5,
+			"vpc": "8",
+		},
+	})
+
+	rates, err := expandRateLimits(d.Get("rate_limits").(map[string]interface{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if rates["ecs"] != 5 || rates["vpc"] != 8 {
+		t.Errorf("unexpected rate limits: %#v", rates)
+	}
+
+	if _, err := expandRateLimits(map[string]interface{}{"ecs": "fast"}); err == nil {
+		t.Errorf("expected an error for a non-numeric rate limit")
+	}
+	if d.Get("max_requests_per_second").(int) != 20 {
+		t.Errorf("unexpected max_requests_per_second: %#v", d.Get("max_requests_per_second"))
+	}
+}
@@ -0,0 +1,462 @@
+package huaweicloud
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/configurations"
+)
+
+func resourceASConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceASConfigurationCreate,
+		Read:   resourceASConfigurationRead,
+		Delete: resourceASConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_configuration_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"scaling_configuration_name_prefix"},
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if len(value) > 64 || len(value) < 1 {
+						errors = append(errors, fmt.Errorf("%q must contain 1 to 64 characters", k))
+					}
+					return
+				},
+			},
+			"scaling_configuration_name_prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					// resource.PrefixedUniqueId appends 26 characters to the prefix.
+					value := v.(string)
+					if len(value) > 38 {
+						errors = append(errors, fmt.Errorf("%q cannot be longer than 38 characters", k))
+					}
+					return
+				},
+			},
+			"instance_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"flavor": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"image": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"disk": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"size": &schema.Schema{
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+									"volume_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+											return ValidateStringList(v, k, []string{"SATA", "SAS", "SSD"})
+										},
+									},
+									"disk_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+											return ValidateStringList(v, k, []string{"SYS", "DATA"})
+										},
+									},
+									"kms_id": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"key_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"user_data": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							// just stash the hash for state & diff comparisons
+							StateFunc: func(v interface{}) string {
+								switch v.(type) {
+								case string:
+									hash := sha1.Sum([]byte(v.(string)))
+									return hex.EncodeToString(hash[:])
+								default:
+									return ""
+								}
+							},
+						},
+						"personality": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"content": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"public_ip": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"eip": &schema.Schema{
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ip_type": &schema.Schema{
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"bandwidth": &schema.Schema{
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"size": &schema.Schema{
+																Type:     schema.TypeInt,
+																Required: true,
+																ForceNew: true,
+															},
+															"share_type": &schema.Schema{
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+															"charging_mode": &schema.Schema{
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"metadata": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getASConfigurationDisks(rawDisks []interface{}) ([]ASConfigurationDiskOpts, error) {
+	var disks []ASConfigurationDiskOpts
+	sysDisks := 0
+	for _, raw := range rawDisks {
+		rawDisk := raw.(map[string]interface{})
+		disk := ASConfigurationDiskOpts{
+			DiskOpts: configurations.DiskOpts{
+				Size:       rawDisk["size"].(int),
+				VolumeType: rawDisk["volume_type"].(string),
+				DiskType:   rawDisk["disk_type"].(string),
+			},
+		}
+
+		if disk.DiskType == "SYS" {
+			sysDisks++
+		}
+
+		if kmsID := rawDisk["kms_id"].(string); kmsID != "" {
+			if disk.DiskType != "DATA" {
+				return nil, fmt.Errorf("kms_id can only be set on disks with disk_type DATA")
+			}
+			disk.Metadata = map[string]string{
+				"__system__encrypted": "1",
+				"__system__cmkid":     kmsID,
+			}
+		}
+
+		disks = append(disks, disk)
+	}
+
+	if len(disks) > 0 && sysDisks != 1 {
+		return nil, fmt.Errorf("Exactly one disk with disk_type SYS must be specified, got %d", sysDisks)
+	}
+
+	return disks, nil
+}
+
+func getASConfigurationPersonality(rawPersonality []interface{}) []configurations.PersonalityOpts {
+	var personality []configurations.PersonalityOpts
+	for _, raw := range rawPersonality {
+		rawFile := raw.(map[string]interface{})
+		personality = append(personality, configurations.PersonalityOpts{
+			Path:    rawFile["path"].(string),
+			Content: base64.StdEncoding.EncodeToString([]byte(rawFile["content"].(string))),
+		})
+	}
+	return personality
+}
+
+func getASConfigurationPublicIP(rawPublicIP []interface{}) configurations.PublicIpOpts {
+	if len(rawPublicIP) == 0 {
+		return configurations.PublicIpOpts{}
+	}
+
+	rawEip := rawPublicIP[0].(map[string]interface{})["eip"].([]interface{})[0].(map[string]interface{})
+	rawBandwidth := rawEip["bandwidth"].([]interface{})[0].(map[string]interface{})
+
+	return configurations.PublicIpOpts{
+		Eip: configurations.EipOpts{
+			IpType: rawEip["ip_type"].(string),
+			Bandwidth: configurations.BandwidthOpts{
+				Size:         rawBandwidth["size"].(int),
+				ShareType:    rawBandwidth["share_type"].(string),
+				ChargingMode: rawBandwidth["charging_mode"].(string),
+			},
+		},
+	}
+}
+
+func getASConfigurationInstanceConfig(d *schema.ResourceData) (configurations.InstanceConfigOpts, []ASConfigurationDiskOpts, error) {
+	rawConfig := d.Get("instance_config").([]interface{})[0].(map[string]interface{})
+
+	disks, err := getASConfigurationDisks(rawConfig["disk"].([]interface{}))
+	if err != nil {
+		return configurations.InstanceConfigOpts{}, nil, err
+	}
+
+	instanceConfig := configurations.InstanceConfigOpts{
+		ID:          rawConfig["instance_id"].(string),
+		FlavorRef:   rawConfig["flavor"].(string),
+		ImageRef:    rawConfig["image"].(string),
+		SSHKey:      rawConfig["key_name"].(string),
+		Personality: getASConfigurationPersonality(rawConfig["personality"].([]interface{})),
+		PubicIp:     getASConfigurationPublicIP(rawConfig["public_ip"].([]interface{})),
+		Metadata:    rawConfig["metadata"].(map[string]interface{}),
+	}
+
+	if instanceConfig.ID == "" && (instanceConfig.FlavorRef == "" || instanceConfig.ImageRef == "" || len(disks) == 0) {
+		return instanceConfig, nil, fmt.Errorf("flavor, image and disk must be set when instance_id is not set")
+	}
+
+	if userData := rawConfig["user_data"].(string); userData != "" {
+		instanceConfig.UserData = []byte(userData)
+	}
+
+	return instanceConfig, disks, nil
+}
+
+func resourceASConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	var name string
+	if v, ok := d.GetOk("scaling_configuration_name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("scaling_configuration_name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.UniqueId()
+	}
+
+	instanceConfig, disks, err := getASConfigurationInstanceConfig(d)
+	if err != nil {
+		return err
+	}
+
+	createOpts := ASConfigurationCreateOpts{
+		CreateOpts: configurations.CreateOpts{
+			Name:           name,
+			InstanceConfig: instanceConfig,
+		},
+		Disk: disks,
+	}
+
+	log.Printf("[DEBUG] Create AS Configuration Options: %#v", createOpts)
+	asConfigID, err := configurations.Create(asClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating AS Configuration: %s", err)
+	}
+
+	d.SetId(asConfigID)
+
+	return resourceASConfigurationRead(d, meta)
+}
+
+func resourceASConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	r := configurations.Get(asClient, d.Id())
+	asConfig, err := r.Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "AS Configuration")
+	}
+	asDisks, err := extractASConfigurationDisks(r)
+	if err != nil {
+		return fmt.Errorf("Error extracting the disks of AS Configuration %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved AS Configuration %s: %#v", d.Id(), asConfig)
+
+	// user_data and personality are not returned in a comparable form, so
+	// the values known to Terraform are kept.
+	instanceConfig := map[string]interface{}{}
+	if v := d.Get("instance_config").([]interface{}); len(v) > 0 {
+		instanceConfig = v[0].(map[string]interface{})
+	}
+
+	disks := make([]map[string]interface{}, len(asDisks))
+	for i, disk := range asDisks {
+		disks[i] = map[string]interface{}{
+			"size":        disk.Size,
+			"volume_type": disk.VolumeType,
+			"disk_type":   disk.DiskType,
+			"kms_id":      disk.Metadata["__system__cmkid"],
+		}
+	}
+
+	var publicIP []map[string]interface{}
+	if eip := asConfig.InstanceConfig.PublicIp.Eip; eip.Type != "" {
+		publicIP = []map[string]interface{}{
+			{
+				"eip": []map[string]interface{}{
+					{
+						"ip_type": eip.Type,
+						"bandwidth": []map[string]interface{}{
+							{
+								"size":          eip.Bandwidth.Size,
+								"share_type":    eip.Bandwidth.ShareType,
+								"charging_mode": eip.Bandwidth.ChargingMode,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	instanceConfig["instance_id"] = asConfig.InstanceConfig.InstanceID
+	instanceConfig["flavor"] = asConfig.InstanceConfig.FlavorRef
+	instanceConfig["image"] = asConfig.InstanceConfig.ImageRef
+	instanceConfig["disk"] = disks
+	instanceConfig["key_name"] = asConfig.InstanceConfig.SSHKey
+	instanceConfig["public_ip"] = publicIP
+
+	d.Set("scaling_configuration_name", asConfig.Name)
+	if err := d.Set("instance_config", []map[string]interface{}{instanceConfig}); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving instance_config to state for AS Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceASConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	// A configuration which has just been detached from a group may still be
+	// reported as in use for a short while.
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		err := configurations.Delete(asClient, d.Id()).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return nil
+			}
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting AS Configuration %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
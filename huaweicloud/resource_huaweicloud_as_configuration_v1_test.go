@@ -0,0 +1,198 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/configurations"
+)
+
+func TestASConfigurationCreateOpts_diskMetadata(t *testing.T) {
+	disks, err := getASConfigurationDisks([]interface{}{
+		map[string]interface{}{"size": 40, "volume_type": "SATA", "disk_type": "SYS", "kms_id": ""},
+		map[string]interface{}{"size": 100, "volume_type": "SSD", "disk_type": "DATA", "kms_id": "key-1"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	opts := ASConfigurationCreateOpts{
+		CreateOpts: configurations.CreateOpts{
+			Name: "as-config",
+			InstanceConfig: configurations.InstanceConfigOpts{
+				FlavorRef: "s3.large.2",
+				ImageRef:  "image-1",
+			},
+		},
+		Disk: disks,
+	}
+	b, err := opts.ToConfigurationCreateMap()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"size": float64(40), "volume_type": "SATA", "disk_type": "SYS"},
+		map[string]interface{}{"size": float64(100), "volume_type": "SSD", "disk_type": "DATA", "metadata": map[string]interface{}{
+			"__system__encrypted": "1",
+			"__system__cmkid":     "key-1",
+		}},
+	}
+	if actual := b["instance_config"].(map[string]interface{})["disk"]; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected disks %#v, got %#v", expected, actual)
+	}
+}
+
+func TestAccASV1Configuration_basic(t *testing.T) {
+	var asConfig configurations.Configuration
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASV1ConfigurationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccASV1Configuration_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1ConfigurationExists("huaweicloud_as_configuration_v1.hth_as_config", &asConfig),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_as_configuration_v1.hth_as_config", "scaling_configuration_name", "hth_as_config"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccASV1Configuration_namePrefix(t *testing.T) {
+	var asConfig configurations.Configuration
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASV1ConfigurationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccASV1Configuration_namePrefix,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1ConfigurationExists("huaweicloud_as_configuration_v1.hth_as_config", &asConfig),
+					resource.TestMatchResourceAttr(
+						"huaweicloud_as_configuration_v1.hth_as_config", "scaling_configuration_name", regexp.MustCompile("^hth-as-config-")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckASV1ConfigurationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	asClient, err := config.autoscalingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_as_configuration_v1" {
+			continue
+		}
+
+		_, err := configurations.Get(asClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("AS configuration still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckASV1ConfigurationExists(n string, asConfig *configurations.Configuration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		asClient, err := config.autoscalingV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+		}
+
+		found, err := configurations.Get(asClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("AS configuration not found")
+		}
+
+		*asConfig = found
+
+		return nil
+	}
+}
+
+var testAccASV1Configuration_basic = fmt.Sprintf(`
+resource "huaweicloud_compute_keypair_v2" "hth_key" {
+  name = "hth_key"
+  public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDAjpC1hwiOCCmKEWxJ4qzTTsJbKzndLo1BCz5PcwtUnflmU+gHJtWMZKpuEGVi29h0A/+ydKek1O18k10Ff+4tyFjiHDQAT9+OfgWf7+b1yK+qDip3X1C0UPMbwHlTfSGWLGZquwhvEFx9k3h/M+VtMvwR1lJ9LUyTAImnNjWG7TAIPmui30HvM2UiFEmqkr4ijq45MyX2+fLIePLRIFuu1p4whjHAQYufqyno3BS48icQb4p6iVEZPo4AE2o9oIyQvj2mx4dk5Y8CgSETOZTYDOR3rU2fZTRDRgPJDH9FWvQjF5tA0p3d9CoWWd2s6GKKbfoUIi8R/Db1BSPJwkqB jrp-hp-pc"
+}
+
+resource "huaweicloud_as_configuration_v1" "hth_as_config"{
+  scaling_configuration_name = "hth_as_config"
+  instance_config = {
+    image = "%s"
+    flavor = "%s"
+    disk = [
+      {
+        size = 40
+        volume_type = "SATA"
+        disk_type = "SYS"
+      },
+    ]
+    key_name = "${huaweicloud_compute_keypair_v2.hth_key.id}"
+    user_data = "#! /bin/bash"
+  }
+}
+`, OS_IMAGE_ID, OS_FLAVOR_ID)
+
+var testAccASV1Configuration_namePrefix = fmt.Sprintf(`
+resource "huaweicloud_compute_keypair_v2" "hth_key" {
+  name = "hth_key"
+  public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDAjpC1hwiOCCmKEWxJ4qzTTsJbKzndLo1BCz5PcwtUnflmU+gHJtWMZKpuEGVi29h0A/+ydKek1O18k10Ff+4tyFjiHDQAT9+OfgWf7+b1yK+qDip3X1C0UPMbwHlTfSGWLGZquwhvEFx9k3h/M+VtMvwR1lJ9LUyTAImnNjWG7TAIPmui30HvM2UiFEmqkr4ijq45MyX2+fLIePLRIFuu1p4whjHAQYufqyno3BS48icQb4p6iVEZPo4AE2o9oIyQvj2mx4dk5Y8CgSETOZTYDOR3rU2fZTRDRgPJDH9FWvQjF5tA0p3d9CoWWd2s6GKKbfoUIi8R/Db1BSPJwkqB jrp-hp-pc"
+}
+
+resource "huaweicloud_as_configuration_v1" "hth_as_config"{
+  scaling_configuration_name_prefix = "hth-as-config-"
+  instance_config = {
+    image = "%s"
+    flavor = "%s"
+    disk = [
+      {
+        size = 40
+        volume_type = "SATA"
+        disk_type = "SYS"
+      },
+      {
+        size = 100
+        volume_type = "SATA"
+        disk_type = "DATA"
+      },
+    ]
+    key_name = "${huaweicloud_compute_keypair_v2.hth_key.id}"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, OS_IMAGE_ID, OS_FLAVOR_ID)
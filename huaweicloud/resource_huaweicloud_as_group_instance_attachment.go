@@ -0,0 +1,222 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/instances"
+)
+
+func resourceASGroupInstanceAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceASGroupInstanceAttachmentCreate,
+		Read:   resourceASGroupInstanceAttachmentRead,
+		Update: resourceASGroupInstanceAttachmentUpdate,
+		Delete: resourceASGroupInstanceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delete_instance": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"life_cycle_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"health_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceASGroupInstanceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	asgID := d.Get("scaling_group_id").(string)
+	insID := d.Get("instance_id").(string)
+
+	// The group rejects batch operations while it is scaling, so serialize
+	// attachments to the same group.
+	osMutexKV.Lock(asgID)
+	defer osMutexKV.Unlock(asgID)
+
+	if _, err := waitForASGroupStable(asClient, asgID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attaching instance %s to AS Group %s", insID, asgID)
+	err = instances.BatchAdd(asClient, asgID, []string{insID}).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error attaching instance %s to AS Group %s: %s", insID, asgID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"", "PENDING"},
+		Target:     []string{"INSERVICE"},
+		Refresh:    waitForASGroupInstanceState(asClient, asgID, insID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance %s to join AS Group %s: %s", insID, asgID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", asgID, insID))
+
+	return resourceASGroupInstanceAttachmentRead(d, meta)
+}
+
+func resourceASGroupInstanceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	asgID, insID, err := parseASGroupInstanceAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	allIns, err := getASGroupInstances(asClient, asgID)
+	if err != nil {
+		return CheckDeleted(d, err, "AS Group instance attachment")
+	}
+
+	var found *instances.Instance
+	for i := range allIns {
+		if allIns[i].ID == insID {
+			found = &allIns[i]
+			break
+		}
+	}
+	if found == nil {
+		log.Printf("[WARN] Instance %s is no longer in AS Group %s, removing from state", insID, asgID)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Retrieved AS Group instance attachment %s: %#v", d.Id(), *found)
+
+	d.Set("scaling_group_id", asgID)
+	d.Set("instance_id", insID)
+	d.Set("life_cycle_state", found.LifeCycleStatus)
+	d.Set("health_status", found.HealthStatus)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// resourceASGroupInstanceAttachmentUpdate only exists so that delete_instance,
+// which is consulted on detach, can be changed in place.
+func resourceASGroupInstanceAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceASGroupInstanceAttachmentRead(d, meta)
+}
+
+func resourceASGroupInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	asgID, insID, err := parseASGroupInstanceAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(asgID)
+	defer osMutexKV.Unlock(asgID)
+
+	if _, err := waitForASGroupStable(asClient, asgID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return CheckDeleted(d, err, "AS Group instance attachment")
+	}
+
+	deleteIns := "no"
+	if d.Get("delete_instance").(bool) {
+		deleteIns = "yes"
+	}
+
+	log.Printf("[DEBUG] Detaching instance %s from AS Group %s (delete instance: %s)", insID, asgID, deleteIns)
+	err = removeASGroupInstances(asClient, asgID, []string{insID}, deleteIns, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForASGroupInstanceState(client *golangsdk.ServiceClient, asgID, insID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		allIns, err := getASGroupInstances(client, asgID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, ins := range allIns {
+			if ins.ID != insID {
+				continue
+			}
+			log.Printf("[DEBUG] Instance %s in AS Group %s is %s", insID, asgID, ins.LifeCycleStatus)
+			if ins.LifeCycleStatus == "ERROR" {
+				return ins, ins.LifeCycleStatus, fmt.Errorf("Instance %s failed to join AS Group %s", insID, asgID)
+			}
+			if ins.LifeCycleStatus == "INSERVICE" {
+				return ins, ins.LifeCycleStatus, nil
+			}
+			return ins, "PENDING", nil
+		}
+
+		// The instance may not be listed right after the batch request.
+		return allIns, "", nil
+	}
+}
+
+func parseASGroupInstanceAttachmentId(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine AS Group instance attachment ID from %q, expected <scaling_group_id>/<instance_id>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
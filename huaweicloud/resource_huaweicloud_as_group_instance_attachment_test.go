@@ -0,0 +1,136 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccASGroupInstanceAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASGroupInstanceAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccASGroupInstanceAttachment_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASGroupInstanceAttachmentExists("huaweicloud_as_group_instance_attachment.attach_1"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_as_group_instance_attachment.attach_1", "life_cycle_state", "INSERVICE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckASGroupInstanceAttachmentDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	asClient, err := config.autoscalingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_as_group_instance_attachment" {
+			continue
+		}
+
+		asgID, insID, err := parseASGroupInstanceAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		allIns, err := getASGroupInstances(asClient, asgID)
+		if err != nil {
+			continue
+		}
+
+		for _, ins := range allIns {
+			if ins.ID == insID {
+				return fmt.Errorf("Instance %s is still attached to AS Group %s", insID, asgID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckASGroupInstanceAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		asClient, err := config.autoscalingV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+		}
+
+		asgID, insID, err := parseASGroupInstanceAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		allIns, err := getASGroupInstances(asClient, asgID)
+		if err != nil {
+			return err
+		}
+
+		for _, ins := range allIns {
+			if ins.ID == insID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Instance %s is not attached to AS Group %s", insID, asgID)
+	}
+}
+
+var testAccASGroupInstanceAttachment_basic = fmt.Sprintf(`
+resource "huaweicloud_networking_secgroup_v2" "secgroup" {
+  name        = "terraform"
+  description = "This is a terraform test security group"
+}
+
+resource "huaweicloud_as_group_v1" "hth_as_group"{
+  scaling_group_name = "hth_as_group"
+  min_instance_number = 0
+  max_instance_number = 3
+  networks = [
+    {
+      id = "%s"
+    },
+  ]
+  security_groups = [
+    {
+      id = "${huaweicloud_networking_secgroup_v2.secgroup.id}"
+    },
+  ]
+  vpc_id = "%s"
+}
+
+resource "huaweicloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  image_id = "%s"
+  flavor_id = "%s"
+  security_groups = ["${huaweicloud_networking_secgroup_v2.secgroup.name}"]
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "huaweicloud_as_group_instance_attachment" "attach_1" {
+  scaling_group_id = "${huaweicloud_as_group_v1.hth_as_group.id}"
+  instance_id = "${huaweicloud_compute_instance_v2.instance_1.id}"
+}
+`, OS_NETWORK_ID, OS_VPC_ID, OS_IMAGE_ID, OS_FLAVOR_ID, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
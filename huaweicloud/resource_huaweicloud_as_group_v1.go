@@ -0,0 +1,660 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/groups"
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/instances"
+)
+
+func resourceASGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceASGroupCreate,
+		Read:   resourceASGroupRead,
+		Update: resourceASGroupUpdate,
+		Delete: resourceASGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if len(value) > 64 || len(value) < 1 {
+						errors = append(errors, fmt.Errorf("%q must contain 1 to 64 characters", k))
+					}
+					return
+				},
+			},
+			"scaling_configuration_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"desire_instance_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"min_instance_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"max_instance_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"cool_down_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  900,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if value < 0 || value > 86400 {
+						errors = append(errors, fmt.Errorf("%q must be between 0 and 86400 seconds", k))
+					}
+					return
+				},
+			},
+			"lb_listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"available_zones": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"networks": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"security_groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"health_periodic_audit_method": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "NOVA_AUDIT",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"NOVA_AUDIT", "ELB_AUDIT"})
+				},
+			},
+			"health_periodic_audit_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if value != 5 && value != 15 && value != 60 && value != 180 {
+						errors = append(errors, fmt.Errorf("%q must be one of 5, 15, 60 or 180 minutes", k))
+					}
+					return
+				},
+			},
+			"instance_terminate_policy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "OLD_CONFIG_OLD_INSTANCE",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"OLD_CONFIG_OLD_INSTANCE",
+						"OLD_CONFIG_NEW_INSTANCE", "OLD_INSTANCE", "NEW_INSTANCE"})
+				},
+			},
+			"notifications": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_publicip": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delete_instances": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "no",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"yes", "no"})
+				},
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_instance_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func getASGroupNetworks(d *schema.ResourceData) []groups.NetworkOpts {
+	var networks []groups.NetworkOpts
+	for _, v := range d.Get("networks").([]interface{}) {
+		network := v.(map[string]interface{})
+		networks = append(networks, groups.NetworkOpts{
+			ID: network["id"].(string),
+		})
+	}
+	return networks
+}
+
+func getASGroupSecurityGroups(d *schema.ResourceData) []groups.SecurityGroupOpts {
+	var secgroups []groups.SecurityGroupOpts
+	for _, v := range d.Get("security_groups").([]interface{}) {
+		secgroup := v.(map[string]interface{})
+		secgroups = append(secgroups, groups.SecurityGroupOpts{
+			ID: secgroup["id"].(string),
+		})
+	}
+	return secgroups
+}
+
+func getASGroupStrings(d *schema.ResourceData, key string) []string {
+	var list []string
+	for _, v := range d.Get(key).([]interface{}) {
+		list = append(list, v.(string))
+	}
+	return list
+}
+
+func validateASGroupInstanceNumbers(min, max, desire int) error {
+	if min > max {
+		return fmt.Errorf("min_instance_number (%d) must not be greater than max_instance_number (%d)", min, max)
+	}
+	if desire != 0 && (desire < min || desire > max) {
+		return fmt.Errorf("desire_instance_number (%d) must be between min_instance_number (%d) and max_instance_number (%d)",
+			desire, min, max)
+	}
+	return nil
+}
+
+func resourceASGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	minNum := d.Get("min_instance_number").(int)
+	maxNum := d.Get("max_instance_number").(int)
+	desireNum := d.Get("desire_instance_number").(int)
+	if err := validateASGroupInstanceNumbers(minNum, maxNum, desireNum); err != nil {
+		return err
+	}
+
+	if d.Get("health_periodic_audit_method").(string) == "ELB_AUDIT" && d.Get("lb_listener_id").(string) == "" {
+		return fmt.Errorf("lb_listener_id must be set when health_periodic_audit_method is ELB_AUDIT")
+	}
+
+	createOpts := groups.CreateOpts{
+		Name:                      d.Get("scaling_group_name").(string),
+		ConfigurationID:           d.Get("scaling_configuration_id").(string),
+		DesireInstanceNumber:      desireNum,
+		MinInstanceNumber:         minNum,
+		MaxInstanceNumber:         maxNum,
+		CoolDownTime:              d.Get("cool_down_time").(int),
+		LBListenerID:              d.Get("lb_listener_id").(string),
+		AvailableZones:            getASGroupStrings(d, "available_zones"),
+		Networks:                  getASGroupNetworks(d),
+		SecurityGroup:             getASGroupSecurityGroups(d),
+		VpcID:                     d.Get("vpc_id").(string),
+		HealthPeriodicAuditMethod: d.Get("health_periodic_audit_method").(string),
+		HealthPeriodicAuditTime:   d.Get("health_periodic_audit_time").(int),
+		InstanceTerminatePolicy:   d.Get("instance_terminate_policy").(string),
+		Notifications:             getASGroupStrings(d, "notifications"),
+		IsDeletePublicip:          d.Get("delete_publicip").(bool),
+	}
+
+	log.Printf("[DEBUG] Create AS Group Options: %#v", createOpts)
+	asgID, err := groups.Create(asClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating AS Group: %s", err)
+	}
+
+	d.SetId(asgID)
+
+	if err := setASGroupEnabled(d, asClient, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceASGroupRead(d, meta)
+}
+
+func resourceASGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	asg, err := groups.Get(asClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "AS Group")
+	}
+
+	log.Printf("[DEBUG] Retrieved AS Group %s: %#v", d.Id(), asg)
+
+	networks := make([]map[string]interface{}, len(asg.Networks))
+	for i, network := range asg.Networks {
+		networks[i] = map[string]interface{}{
+			"id": network.ID,
+		}
+	}
+
+	secgroups := make([]map[string]interface{}, len(asg.SecurityGroups))
+	for i, secgroup := range asg.SecurityGroups {
+		secgroups[i] = map[string]interface{}{
+			"id": secgroup.ID,
+		}
+	}
+
+	allIns, err := getASGroupInstances(asClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error listing instances of AS Group %s: %s", d.Id(), err)
+	}
+	insIDs := make([]string, len(allIns))
+	for i, ins := range allIns {
+		insIDs[i] = ins.ID
+	}
+
+	d.Set("scaling_group_name", asg.Name)
+	d.Set("scaling_configuration_id", asg.ConfigurationID)
+	d.Set("desire_instance_number", asg.DesireInstanceNumber)
+	d.Set("min_instance_number", asg.MinInstanceNumber)
+	d.Set("max_instance_number", asg.MaxInstanceNumber)
+	d.Set("cool_down_time", asg.CoolDownTime)
+	d.Set("lb_listener_id", asg.LBListenerID)
+	d.Set("available_zones", asg.AvailableZones)
+	d.Set("networks", networks)
+	d.Set("security_groups", secgroups)
+	d.Set("vpc_id", asg.VpcID)
+	d.Set("health_periodic_audit_method", asg.HealthPeriodicAuditMethod)
+	d.Set("health_periodic_audit_time", asg.HealthPeriodicAuditTime)
+	d.Set("instance_terminate_policy", asg.InstanceTerminatePolicy)
+	d.Set("notifications", asg.Notifications)
+	d.Set("delete_publicip", asg.DeletePublicip)
+	d.Set("enabled", asg.Status == "INSERVICE")
+	d.Set("status", asg.Status)
+	d.Set("current_instance_number", asg.ActualInstanceNumber)
+	d.Set("instances", insIDs)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceASGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	minNum := d.Get("min_instance_number").(int)
+	maxNum := d.Get("max_instance_number").(int)
+	desireNum := d.Get("desire_instance_number").(int)
+	if err := validateASGroupInstanceNumbers(minNum, maxNum, desireNum); err != nil {
+		return err
+	}
+
+	if d.HasChange("scaling_group_name") || d.HasChange("scaling_configuration_id") ||
+		d.HasChange("desire_instance_number") || d.HasChange("min_instance_number") ||
+		d.HasChange("max_instance_number") || d.HasChange("cool_down_time") ||
+		d.HasChange("lb_listener_id") || d.HasChange("available_zones") ||
+		d.HasChange("networks") || d.HasChange("security_groups") ||
+		d.HasChange("health_periodic_audit_method") || d.HasChange("health_periodic_audit_time") ||
+		d.HasChange("instance_terminate_policy") || d.HasChange("notifications") ||
+		d.HasChange("delete_publicip") {
+		updateOpts := ASGroupUpdateOpts{
+			UpdateOpts: groups.UpdateOpts{
+				Name:                      d.Get("scaling_group_name").(string),
+				ConfigurationID:           d.Get("scaling_configuration_id").(string),
+				MaxInstanceNumber:         maxNum,
+				CoolDownTime:              d.Get("cool_down_time").(int),
+				LBListenerID:              d.Get("lb_listener_id").(string),
+				AvailableZones:            getASGroupStrings(d, "available_zones"),
+				Networks:                  getASGroupNetworks(d),
+				SecurityGroup:             getASGroupSecurityGroups(d),
+				HealthPeriodicAuditMethod: d.Get("health_periodic_audit_method").(string),
+				HealthPeriodicAuditTime:   d.Get("health_periodic_audit_time").(int),
+				InstanceTerminatePolicy:   d.Get("instance_terminate_policy").(string),
+				Notifications:             getASGroupStrings(d, "notifications"),
+			},
+			MinInstanceNumber:    minNum,
+			DesireInstanceNumber: desireNum,
+			IsDeletePublicip:     d.Get("delete_publicip").(bool),
+		}
+
+		log.Printf("[DEBUG] Update AS Group Options: %#v", updateOpts)
+		_, err = groups.Update(asClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating AS Group %s: %s", d.Id(), err)
+		}
+	}
+
+	if err := setASGroupEnabled(d, asClient, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceASGroupRead(d, meta)
+}
+
+func resourceASGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	allIns, err := getASGroupInstances(asClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "AS Group")
+	}
+
+	// A group can only be deleted once it has no instances left in it.
+	if len(allIns) > 0 {
+		var insIDs []string
+		for _, ins := range allIns {
+			insIDs = append(insIDs, ins.ID)
+		}
+
+		// Instances can only be removed down to the minimum number, and the
+		// group must not replace them while they are being removed.
+		updateOpts := ASGroupUpdateOpts{
+			MinInstanceNumber:    0,
+			DesireInstanceNumber: len(allIns),
+			IsDeletePublicip:     d.Get("delete_publicip").(bool),
+		}
+		_, err = groups.Update(asClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating AS Group %s: %s", d.Id(), err)
+		}
+
+		deleteIns := d.Get("delete_instances").(string)
+		log.Printf("[DEBUG] Removing instances %v from AS Group %s, delete_instances: %s", insIDs, d.Id(), deleteIns)
+		if err := removeASGroupInstances(asClient, d.Id(), insIDs, deleteIns, timeout); err != nil {
+			return err
+		}
+	}
+
+	if err := groups.Delete(asClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error deleting AS Group")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForASGroupDelete(asClient, d.Id()),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting AS Group %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// setASGroupEnabled resumes or pauses the group according to the enabled
+// argument and waits for the group to settle in the requested status.
+func setASGroupEnabled(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) error {
+	asg, err := waitForASGroupStable(client, d.Id(), timeout)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("enabled").(bool) {
+		if asg.Status != "INSERVICE" {
+			log.Printf("[DEBUG] Enabling AS Group %s", d.Id())
+			if err := groups.Enable(client, d.Id()).ExtractErr(); err != nil {
+				return fmt.Errorf("Error enabling AS Group %s: %s", d.Id(), err)
+			}
+		}
+	} else if asg.Status == "INSERVICE" {
+		log.Printf("[DEBUG] Disabling AS Group %s", d.Id())
+		if err := groups.Disable(client, d.Id()).ExtractErr(); err != nil {
+			return fmt.Errorf("Error disabling AS Group %s: %s", d.Id(), err)
+		}
+	}
+
+	target := "PAUSED"
+	if d.Get("enabled").(bool) {
+		target = "INSERVICE"
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{target},
+		Refresh:    waitForASGroupActive(client, d.Id(), target),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for AS Group (%s) to become %s: %s", d.Id(), target, err)
+	}
+
+	return nil
+}
+
+func waitForASGroupStable(client *golangsdk.ServiceClient, asgID string, timeout time.Duration) (groups.Group, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"INSERVICE", "PAUSED"},
+		Refresh:    waitForASGroupActive(client, asgID, ""),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	v, err := stateConf.WaitForState()
+	if err != nil {
+		return groups.Group{}, fmt.Errorf("Error waiting for AS Group (%s) to become ready: %s", asgID, err)
+	}
+
+	return v.(groups.Group), nil
+}
+
+// waitForASGroupActive reports the group as PENDING until it has finished
+// scaling and, when target is set, until it has reached the target status.
+func waitForASGroupActive(client *golangsdk.ServiceClient, asgID, target string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		asg, err := groups.Get(client, asgID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud AS Group %s status: %s, scaling: %t", asgID, asg.Status, asg.IsScaling)
+		if asg.Status == "ERROR" {
+			return asg, asg.Status, fmt.Errorf("AS Group %s is in ERROR status: %s", asgID, asg.Detail)
+		}
+
+		if asg.IsScaling || (target != "" && asg.Status != target) {
+			return asg, "PENDING", nil
+		}
+
+		if asg.Status == "INSERVICE" || asg.Status == "PAUSED" {
+			return asg, asg.Status, nil
+		}
+
+		return asg, "PENDING", nil
+	}
+}
+
+func waitForASGroupDelete(client *golangsdk.ServiceClient, asgID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		asg, err := groups.Get(client, asgID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted AS Group %s", asgID)
+				return asg, "DELETED", nil
+			}
+			return asg, "ACTIVE", err
+		}
+
+		log.Printf("[DEBUG] AS Group %s still exists, status: %s", asgID, asg.Status)
+		return asg, "ACTIVE", nil
+	}
+}
+
+func getASGroupInstances(client *golangsdk.ServiceClient, asgID string) ([]instances.Instance, error) {
+	return listASGroupInstances(client, asgID, ASInstancesListOpts{})
+}
+
+// asGroupInstancesPageSize is the largest number of instances the API
+// returns in one page.
+const asGroupInstancesPageSize = 100
+
+// listASGroupInstances pages through all the instances of the group which
+// match the options. The API only returns one page per request, and tells
+// the total number of matching instances in total_number.
+func listASGroupInstances(client *golangsdk.ServiceClient, asgID string, opts ASInstancesListOpts) ([]instances.Instance, error) {
+	opts.Limit = asGroupInstancesPageSize
+	opts.StartNumber = 0
+
+	var allIns []instances.Instance
+	for {
+		page, err := instances.List(client, asgID, opts).AllPages()
+		if err != nil {
+			return nil, err
+		}
+
+		ins, err := page.(instances.InstancePage).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		var s struct {
+			TotalNumber int `json:"total_number"`
+		}
+		if err := page.(instances.InstancePage).ExtractInto(&s); err != nil {
+			return nil, err
+		}
+
+		allIns = append(allIns, ins...)
+		opts.StartNumber += len(ins)
+		if len(ins) == 0 || opts.StartNumber >= s.TotalNumber {
+			return allIns, nil
+		}
+	}
+}
+
+// removeASGroupInstances removes the given instances from the group, at most
+// ten at a time as the batch API requires, and waits until they are gone.
+func removeASGroupInstances(client *golangsdk.ServiceClient, asgID string, insIDs []string, deleteIns string, timeout time.Duration) error {
+	for i := 0; i < len(insIDs); i += 10 {
+		end := i + 10
+		if end > len(insIDs) {
+			end = len(insIDs)
+		}
+
+		err := instances.BatchDelete(client, asgID, insIDs[i:end], deleteIns).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error removing instances from AS Group %s: %s", asgID, err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"REMOVING"},
+		Target:     []string{"REMOVED"},
+		Refresh:    waitForASGroupInstancesRemoved(client, asgID, insIDs),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instances to be removed from AS Group %s: %s", asgID, err)
+	}
+
+	return nil
+}
+
+func waitForASGroupInstancesRemoved(client *golangsdk.ServiceClient, asgID string, insIDs []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		allIns, err := getASGroupInstances(client, asgID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, ins := range allIns {
+			for _, id := range insIDs {
+				if ins.ID == id {
+					log.Printf("[DEBUG] Instance %s is still in AS Group %s: %s", id, asgID, ins.LifeCycleStatus)
+					return allIns, "REMOVING", nil
+				}
+			}
+		}
+
+		return allIns, "REMOVED", nil
+	}
+}
@@ -0,0 +1,220 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/groups"
+)
+
+func TestListASGroupInstances(t *testing.T) {
+	const total = 250
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/autoscaling-api/v1/0123456789abcdef/scaling_group_instance/asg/list" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		if r.URL.Query().Get("life_cycle_state") != "INSERVICE" {
+			t.Errorf("Expected the life_cycle_state filter in %s", r.URL)
+		}
+		start, _ := strconv.Atoi(r.URL.Query().Get("start_number"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"total_number":%d,"start_number":%d,"limit":%d,"scaling_group_instances":[`, total, start, limit)
+		for i := start; i < start+limit && i < total; i++ {
+			if i > start {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"instance_id":"ins-%d","life_cycle_state":"INSERVICE"}`, i)
+		}
+		fmt.Fprint(w, "]}")
+	}))
+	defer ts.Close()
+
+	config := &Config{
+		HwClient:  &golangsdk.ProviderClient{ProjectID: "0123456789abcdef"},
+		Region:    "cn-north-1",
+		Endpoints: map[string]string{"as": ts.URL},
+	}
+	client, err := config.autoscalingV1Client("cn-north-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	allIns, err := listASGroupInstances(client, "asg", ASInstancesListOpts{LifeCycleStatus: "INSERVICE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(allIns) != total {
+		t.Fatalf("Expected %d instances, got %d", total, len(allIns))
+	}
+	if allIns[total-1].ID != fmt.Sprintf("ins-%d", total-1) {
+		t.Fatalf("Unexpected last instance: %#v", allIns[total-1])
+	}
+	if requests != 3 {
+		t.Fatalf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestASGroupUpdateOpts_zeroValues(t *testing.T) {
+	opts := ASGroupUpdateOpts{
+		UpdateOpts: groups.UpdateOpts{
+			Name: "as-group",
+		},
+	}
+	b, err := opts.ToGroupUpdateMap()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for _, k := range []string{"min_instance_number", "desire_instance_number", "delete_publicip"} {
+		if _, ok := b[k]; !ok {
+			t.Errorf("Expected %s to be sent, got %#v", k, b)
+		}
+	}
+	if v := b["delete_publicip"]; v != false {
+		t.Errorf("Expected delete_publicip to be false, got %#v", v)
+	}
+}
+
+func TestAccASV1Group_basic(t *testing.T) {
+	var asGroup groups.Group
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASV1GroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccASV1Group_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1GroupExists("huaweicloud_as_group_v1.hth_as_group", &asGroup),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_as_group_v1.hth_as_group", "status", "INSERVICE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccASV1Group_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1GroupExists("huaweicloud_as_group_v1.hth_as_group", &asGroup),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_as_group_v1.hth_as_group", "scaling_group_name", "hth_as_group_updated"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_as_group_v1.hth_as_group", "status", "PAUSED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckASV1GroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	asClient, err := config.autoscalingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_as_group_v1" {
+			continue
+		}
+
+		_, err := groups.Get(asClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("AS group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckASV1GroupExists(n string, group *groups.Group) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		asClient, err := config.autoscalingV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+		}
+
+		found, err := groups.Get(asClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("AS group not found")
+		}
+
+		*group = found
+
+		return nil
+	}
+}
+
+var testAccASV1Group_basic = fmt.Sprintf(`
+resource "huaweicloud_networking_secgroup_v2" "secgroup" {
+  name        = "terraform"
+  description = "This is a terraform test security group"
+}
+
+resource "huaweicloud_as_group_v1" "hth_as_group"{
+  scaling_group_name = "hth_as_group"
+  min_instance_number = 0
+  max_instance_number = 3
+  networks = [
+    {
+      id = "%s"
+    },
+  ]
+  security_groups = [
+    {
+      id = "${huaweicloud_networking_secgroup_v2.secgroup.id}"
+    },
+  ]
+  vpc_id = "%s"
+}
+`, OS_NETWORK_ID, OS_VPC_ID)
+
+var testAccASV1Group_update = fmt.Sprintf(`
+resource "huaweicloud_networking_secgroup_v2" "secgroup" {
+  name        = "terraform"
+  description = "This is a terraform test security group"
+}
+
+resource "huaweicloud_as_group_v1" "hth_as_group"{
+  scaling_group_name = "hth_as_group_updated"
+  min_instance_number = 0
+  max_instance_number = 5
+  cool_down_time = 600
+  networks = [
+    {
+      id = "%s"
+    },
+  ]
+  security_groups = [
+    {
+      id = "${huaweicloud_networking_secgroup_v2.secgroup.id}"
+    },
+  ]
+  vpc_id = "%s"
+  enabled = false
+}
+`, OS_NETWORK_ID, OS_VPC_ID)
@@ -0,0 +1,405 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/policies"
+)
+
+const (
+	asPolicyTimeFormat       = "2006-01-02T15:04Z"
+	asPolicyLaunchTimeFormat = "15:04"
+)
+
+func resourceASPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceASPolicyCreate,
+		Read:   resourceASPolicyRead,
+		Update: resourceASPolicyUpdate,
+		Delete: resourceASPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_policy_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					vv := regexp.MustCompile("^[a-zA-Z0-9-_]{1,64}$")
+					if !vv.MatchString(value) {
+						errors = append(errors, fmt.Errorf("%s is a string of 1 to 64 characters that consist of letters, digits, underscores (_), and hyphens (-)", k))
+					}
+					return
+				},
+			},
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scaling_policy_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"ALARM", "SCHEDULED", "RECURRENCE"})
+				},
+			},
+			"alarm_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"scheduled_policy": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"launch_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"recurrence_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"Daily", "Weekly", "Monthly"})
+							},
+						},
+						"recurrence_value": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"end_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"scaling_policy_action": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operation": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "ADD",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"ADD", "REMOVE", "SET"})
+							},
+						},
+						"instance_number": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+					},
+				},
+			},
+			"cool_down_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  900,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if value < 0 || value > 86400 {
+						errors = append(errors, fmt.Errorf("%q must be between 0 and 86400 seconds", k))
+					}
+					return
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateASPolicyTrigger checks that exactly the fields required by the
+// policy type are set, so that a misconfigured policy fails before any
+// request is sent.
+func validateASPolicyTrigger(policyType, alarmID string, rawSchedule []interface{}) error {
+	var schedule map[string]interface{}
+	if len(rawSchedule) > 0 && rawSchedule[0] != nil {
+		schedule = rawSchedule[0].(map[string]interface{})
+	}
+
+	switch policyType {
+	case "ALARM":
+		if alarmID == "" {
+			return fmt.Errorf("alarm_id must be set when scaling_policy_type is ALARM")
+		}
+		if schedule != nil {
+			return fmt.Errorf("scheduled_policy must not be set when scaling_policy_type is ALARM")
+		}
+		return nil
+	case "SCHEDULED", "RECURRENCE":
+		if alarmID != "" {
+			return fmt.Errorf("alarm_id must not be set when scaling_policy_type is %s", policyType)
+		}
+		if schedule == nil {
+			return fmt.Errorf("scheduled_policy must be set when scaling_policy_type is %s", policyType)
+		}
+	default:
+		return fmt.Errorf("Unsupported scaling_policy_type: %s", policyType)
+	}
+
+	launchTime := schedule["launch_time"].(string)
+	recurrenceType := schedule["recurrence_type"].(string)
+	recurrenceValue := schedule["recurrence_value"].(string)
+	startTime := schedule["start_time"].(string)
+	endTime := schedule["end_time"].(string)
+
+	if policyType == "SCHEDULED" {
+		if _, err := time.Parse(asPolicyTimeFormat, launchTime); err != nil {
+			return fmt.Errorf("launch_time of a SCHEDULED policy must be in the format YYYY-MM-DDThh:mmZ, got %q", launchTime)
+		}
+		if recurrenceType != "" || recurrenceValue != "" || startTime != "" || endTime != "" {
+			return fmt.Errorf("recurrence_type, recurrence_value, start_time and end_time must not be set when scaling_policy_type is SCHEDULED")
+		}
+		return nil
+	}
+
+	if _, err := time.Parse(asPolicyLaunchTimeFormat, launchTime); err != nil {
+		return fmt.Errorf("launch_time of a RECURRENCE policy must be in the format hh:mm, got %q", launchTime)
+	}
+
+	switch recurrenceType {
+	case "Daily":
+		if recurrenceValue != "" {
+			return fmt.Errorf("recurrence_value must not be set when recurrence_type is Daily")
+		}
+	case "Weekly":
+		if err := validateASPolicyRecurrenceValue(recurrenceValue, 1, 7); err != nil {
+			return fmt.Errorf("recurrence_value of a Weekly recurrence must be a comma separated list of days between 1 (Sunday) and 7 (Saturday): %s", err)
+		}
+	case "Monthly":
+		if err := validateASPolicyRecurrenceValue(recurrenceValue, 1, 31); err != nil {
+			return fmt.Errorf("recurrence_value of a Monthly recurrence must be a comma separated list of days between 1 and 31: %s", err)
+		}
+	default:
+		return fmt.Errorf("recurrence_type must be one of Daily, Weekly or Monthly when scaling_policy_type is RECURRENCE")
+	}
+
+	if endTime == "" {
+		return fmt.Errorf("end_time must be set when scaling_policy_type is RECURRENCE")
+	}
+	end, err := time.Parse(asPolicyTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("end_time must be in the format YYYY-MM-DDThh:mmZ, got %q", endTime)
+	}
+	if startTime != "" {
+		start, err := time.Parse(asPolicyTimeFormat, startTime)
+		if err != nil {
+			return fmt.Errorf("start_time must be in the format YYYY-MM-DDThh:mmZ, got %q", startTime)
+		}
+		if !end.After(start) {
+			return fmt.Errorf("end_time (%s) must be later than start_time (%s)", endTime, startTime)
+		}
+	}
+
+	return nil
+}
+
+func validateASPolicyRecurrenceValue(value string, min, max int) error {
+	if value == "" {
+		return fmt.Errorf("value is empty")
+	}
+
+	for _, day := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(day)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", day)
+		}
+		if n < min || n > max {
+			return fmt.Errorf("%d is out of range", n)
+		}
+	}
+
+	return nil
+}
+
+func getASPolicySchedule(d *schema.ResourceData) policies.SchedulePolicyOpts {
+	rawSchedule := d.Get("scheduled_policy").([]interface{})
+	if len(rawSchedule) == 0 {
+		return policies.SchedulePolicyOpts{}
+	}
+
+	schedule := rawSchedule[0].(map[string]interface{})
+	return policies.SchedulePolicyOpts{
+		LaunchTime:      schedule["launch_time"].(string),
+		RecurrenceType:  schedule["recurrence_type"].(string),
+		RecurrenceValue: schedule["recurrence_value"].(string),
+		StartTime:       schedule["start_time"].(string),
+		EndTime:         schedule["end_time"].(string),
+	}
+}
+
+func getASPolicyAction(d *schema.ResourceData) policies.ActionOpts {
+	rawAction := d.Get("scaling_policy_action").([]interface{})
+	if len(rawAction) == 0 {
+		return policies.ActionOpts{}
+	}
+
+	action := rawAction[0].(map[string]interface{})
+	return policies.ActionOpts{
+		Operation:   action["operation"].(string),
+		InstanceNum: action["instance_number"].(int),
+	}
+}
+
+func resourceASPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	policyType := d.Get("scaling_policy_type").(string)
+	alarmID := d.Get("alarm_id").(string)
+	if err := validateASPolicyTrigger(policyType, alarmID, d.Get("scheduled_policy").([]interface{})); err != nil {
+		return err
+	}
+
+	createOpts := ASPolicyCreateOpts{
+		policies.CreateOpts{
+			Name:           d.Get("scaling_policy_name").(string),
+			ID:             d.Get("scaling_group_id").(string),
+			Type:           policyType,
+			AlarmID:        alarmID,
+			SchedulePolicy: getASPolicySchedule(d),
+			Action:         getASPolicyAction(d),
+			CoolDownTime:   d.Get("cool_down_time").(int),
+		},
+	}
+
+	log.Printf("[DEBUG] Create AS Policy Options: %#v", createOpts)
+	asPolicyID, err := policies.Create(asClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating AS Policy: %s", err)
+	}
+
+	d.SetId(asPolicyID)
+
+	return resourceASPolicyRead(d, meta)
+}
+
+func resourceASPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	asPolicy, err := policies.Get(asClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "AS Policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved AS Policy %s: %#v", d.Id(), asPolicy)
+
+	var schedule []map[string]interface{}
+	if asPolicy.Type != "ALARM" {
+		schedule = []map[string]interface{}{
+			{
+				"launch_time":      asPolicy.SchedulePolicy.LaunchTime,
+				"recurrence_type":  asPolicy.SchedulePolicy.RecurrenceType,
+				"recurrence_value": asPolicy.SchedulePolicy.RecurrenceValue,
+				"start_time":       asPolicy.SchedulePolicy.StartTime,
+				"end_time":         asPolicy.SchedulePolicy.EndTime,
+			},
+		}
+	}
+
+	action := []map[string]interface{}{
+		{
+			"operation":       asPolicy.Action.Operation,
+			"instance_number": asPolicy.Action.InstanceNum,
+		},
+	}
+
+	d.Set("scaling_policy_name", asPolicy.Name)
+	// The ID of the policy result is the ID of the group it belongs to.
+	d.Set("scaling_group_id", asPolicy.ID)
+	d.Set("scaling_policy_type", asPolicy.Type)
+	d.Set("alarm_id", asPolicy.AlarmID)
+	d.Set("scheduled_policy", schedule)
+	d.Set("scaling_policy_action", action)
+	d.Set("cool_down_time", asPolicy.CoolDownTime)
+	d.Set("status", asPolicy.Status)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceASPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	policyType := d.Get("scaling_policy_type").(string)
+	alarmID := d.Get("alarm_id").(string)
+	if err := validateASPolicyTrigger(policyType, alarmID, d.Get("scheduled_policy").([]interface{})); err != nil {
+		return err
+	}
+
+	updateOpts := ASPolicyUpdateOpts{
+		policies.UpdateOpts{
+			Name:           d.Get("scaling_policy_name").(string),
+			Type:           policyType,
+			AlarmID:        alarmID,
+			SchedulePolicy: getASPolicySchedule(d),
+			Action:         getASPolicyAction(d),
+			CoolDownTime:   d.Get("cool_down_time").(int),
+		},
+	}
+
+	log.Printf("[DEBUG] Update AS Policy Options: %#v", updateOpts)
+	_, err = policies.Update(asClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating AS Policy %s: %s", d.Id(), err)
+	}
+
+	return resourceASPolicyRead(d, meta)
+}
+
+func resourceASPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	asClient, err := config.autoscalingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	if err := policies.Delete(asClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error deleting AS Policy")
+	}
+
+	d.SetId("")
+	return nil
+}
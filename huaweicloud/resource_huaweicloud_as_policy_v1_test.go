@@ -0,0 +1,168 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/policies"
+)
+
+func TestAccASV1Policy_basic(t *testing.T) {
+	var asPolicy policies.Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASV1PolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccASV1Policy_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1PolicyExists("huaweicloud_as_policy_v1.hth_as_policy", &asPolicy),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_as_policy_v1.hth_as_policy", "scaling_policy_type", "RECURRENCE"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceASPolicyTrigger_validation(t *testing.T) {
+	schedule := func(launch, recType, recValue, start, end string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"launch_time":      launch,
+				"recurrence_type":  recType,
+				"recurrence_value": recValue,
+				"start_time":       start,
+				"end_time":         end,
+			},
+		}
+	}
+
+	var testCases = []struct {
+		Type     string
+		AlarmID  string
+		Schedule []interface{}
+		Valid    bool
+	}{
+		{"ALARM", "al1234", nil, true},
+		{"ALARM", "", nil, false},
+		{"ALARM", "al1234", schedule("2030-01-01T10:00Z", "", "", "", ""), false},
+		{"SCHEDULED", "", schedule("2030-01-01T10:00Z", "", "", "", ""), true},
+		{"SCHEDULED", "", nil, false},
+		{"SCHEDULED", "al1234", schedule("2030-01-01T10:00Z", "", "", "", ""), false},
+		{"SCHEDULED", "", schedule("10:00", "", "", "", ""), false},
+		{"SCHEDULED", "", schedule("2030-01-01T10:00Z", "Daily", "", "", ""), false},
+		{"RECURRENCE", "", schedule("10:00", "Daily", "", "", "2030-12-31T10:00Z"), true},
+		{"RECURRENCE", "", schedule("10:00", "Daily", "1", "", "2030-12-31T10:00Z"), false},
+		{"RECURRENCE", "", schedule("10:00", "Weekly", "1,3,5", "2030-01-01T10:00Z", "2030-12-31T10:00Z"), true},
+		{"RECURRENCE", "", schedule("10:00", "Weekly", "1,8", "", "2030-12-31T10:00Z"), false},
+		{"RECURRENCE", "", schedule("10:00", "Monthly", "1,15,31", "", "2030-12-31T10:00Z"), true},
+		{"RECURRENCE", "", schedule("10:00", "Monthly", "", "", "2030-12-31T10:00Z"), false},
+		{"RECURRENCE", "", schedule("10:00", "", "", "", "2030-12-31T10:00Z"), false},
+		{"RECURRENCE", "", schedule("10:00", "Daily", "", "", ""), false},
+		{"RECURRENCE", "", schedule("10:00", "Daily", "", "2030-12-31T10:00Z", "2030-01-01T10:00Z"), false},
+		{"RECURRENCE", "", schedule("2030-01-01T10:00Z", "Daily", "", "", "2030-12-31T10:00Z"), false},
+	}
+
+	for i, tc := range testCases {
+		err := validateASPolicyTrigger(tc.Type, tc.AlarmID, tc.Schedule)
+		if tc.Valid && err != nil {
+			t.Fatalf("case %d: expected no validation error, got: %s", i, err)
+		}
+		if !tc.Valid && err == nil {
+			t.Fatalf("case %d: expected to trigger a validation error", i)
+		}
+	}
+}
+
+func testAccCheckASV1PolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	asClient, err := config.autoscalingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_as_policy_v1" {
+			continue
+		}
+
+		_, err := policies.Get(asClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("AS policy still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckASV1PolicyExists(n string, policy *policies.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		asClient, err := config.autoscalingV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud autoscaling client: %s", err)
+		}
+
+		found, err := policies.Get(asClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		*policy = found
+
+		return nil
+	}
+}
+
+var testAccASV1Policy_basic = fmt.Sprintf(`
+resource "huaweicloud_networking_secgroup_v2" "secgroup" {
+  name        = "terraform"
+  description = "This is a terraform test security group"
+}
+
+resource "huaweicloud_as_group_v1" "hth_as_group"{
+  scaling_group_name = "hth_as_group"
+  networks = [
+    {
+      id = "%s"
+    },
+  ]
+  security_groups = [
+    {
+      id = "${huaweicloud_networking_secgroup_v2.secgroup.id}"
+    },
+  ]
+  vpc_id = "%s"
+}
+
+resource "huaweicloud_as_policy_v1" "hth_as_policy"{
+  scaling_policy_name = "hth_as_policy"
+  scaling_group_id = "${huaweicloud_as_group_v1.hth_as_group.id}"
+  scaling_policy_type = "RECURRENCE"
+  scheduled_policy = {
+    launch_time = "07:00"
+    recurrence_type = "Weekly"
+    recurrence_value = "2,4,6"
+    end_time = "2030-12-31T12:00Z"
+  }
+  scaling_policy_action = {
+    operation = "ADD"
+    instance_number = 1
+  }
+}
+`, OS_NETWORK_ID, OS_VPC_ID)
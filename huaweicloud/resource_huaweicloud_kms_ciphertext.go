@@ -0,0 +1,98 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceKmsCiphertext() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsCiphertextCreate,
+		Read:   resourceKmsCiphertextRead,
+		Delete: resourceKmsCiphertextDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plaintext": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"encryption_context": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ciphertext_blob": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsCiphertextCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud kms key client: %s", err)
+	}
+
+	encryptOpts := KmsEncryptDataOpts{
+		KeyID:             d.Get("key_id").(string),
+		PlainText:         d.Get("plaintext").(string),
+		EncryptionContext: expandKmsEncryptionContext(d.Get("encryption_context").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] KMS encrypt data with key: %s", encryptOpts.KeyID)
+	v, err := encryptKmsData(kmsKeyV1Client, encryptOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error encrypting data with key %s: %s", encryptOpts.KeyID, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("ciphertext_blob", v.CipherText)
+
+	return resourceKmsCiphertextRead(d, meta)
+}
+
+func resourceKmsCiphertextRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// The ciphertext only exists in the state, there is nothing else to read.
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceKmsCiphertextDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func expandKmsEncryptionContext(raw map[string]interface{}) map[string]string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	context := make(map[string]string, len(raw))
+	for k, v := range raw {
+		context[k] = v.(string)
+	}
+	return context
+}
@@ -0,0 +1,216 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk"
+)
+
+// newTestKMSServer returns a stand-in for the encrypt-data and decrypt-data
+// KMS calls. The ciphertext is the base64 encoded request, so decrypting
+// only succeeds with the encryption context the data was encrypted with.
+func newTestKMSServer(t *testing.T) *httptest.Server {
+	type payload struct {
+		KeyID             string            `json:"key_id"`
+		PlainText         string            `json:"plain_text"`
+		CipherText        string            `json:"cipher_text"`
+		EncryptionContext map[string]string `json:"encryption_context"`
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req payload
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Error decoding request: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/kms/encrypt-data"):
+			b, _ := json.Marshal(payload{KeyID: req.KeyID, PlainText: req.PlainText, EncryptionContext: req.EncryptionContext})
+			fmt.Fprintf(w, `{"key_id":%q,"cipher_text":%q}`, req.KeyID, base64.StdEncoding.EncodeToString(b))
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/kms/decrypt-data"):
+			b, err := base64.StdEncoding.DecodeString(req.CipherText)
+			var encrypted payload
+			if err == nil {
+				err = json.Unmarshal(b, &encrypted)
+			}
+			if err != nil || !reflect.DeepEqual(encrypted.EncryptionContext, req.EncryptionContext) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":{"error_code":"KMS.0205","error_msg":"Invalid ciphertext"}}`)
+				return
+			}
+			fmt.Fprintf(w, `{"key_id":%q,"plain_text":%q}`, encrypted.KeyID, encrypted.PlainText)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestKmsCiphertext_standIn(t *testing.T) {
+	kms := newTestKMSServer(t)
+	defer kms.Close()
+
+	provider := Provider().(*schema.Provider)
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return &Config{
+			HwClient:  &golangsdk.ProviderClient{ProjectID: "0123456789abcdef"},
+			Region:    "cn-north-1",
+			Endpoints: map[string]string{"kms": kms.URL},
+		}, nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]terraform.ResourceProvider{"huaweicloud": provider},
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsCiphertext_basic("key-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"huaweicloud_kms_ciphertext.password", "plaintext", "s3cr3t"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_kms_ciphertext.password", "region", "cn-north-1"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_kms_secrets.secrets", "region", "cn-north-1"),
+					resource.TestCheckResourceAttrSet(
+						"huaweicloud_kms_ciphertext.password", "ciphertext_blob"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_kms_secrets.secrets", "plaintext.password", "s3cr3t"),
+				),
+			},
+		},
+	})
+}
+
+// testHTTPLogWriter keeps the log entries of the LogRoundTripper, leaving out
+// those of Terraform itself.
+type testHTTPLogWriter struct {
+	bytes.Buffer
+}
+
+func (w *testHTTPLogWriter) Write(p []byte) (int, error) {
+	if bytes.Contains(p, []byte("HuaweiCloud Re")) || bytes.Contains(p, []byte("Openstack Re")) {
+		w.Buffer.Write(p)
+	}
+	return len(p), nil
+}
+
+func TestKmsCiphertext_debugLog(t *testing.T) {
+	var buf testHTTPLogWriter
+	defer log.SetOutput(os.Stderr)
+	os.Setenv("OS_DEBUG", "1")
+	defer os.Unsetenv("OS_DEBUG")
+
+	kms := newTestKMSServer(t)
+	defer kms.Close()
+
+	provider := Provider().(*schema.Provider)
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		// resource.Test sets the log output itself, so capture it from here.
+		log.SetOutput(&buf)
+
+		client := &golangsdk.ProviderClient{ProjectID: "0123456789abcdef"}
+		client.HTTPClient = http.Client{
+			Transport: &LogRoundTripper{
+				Rt:      http.DefaultTransport,
+				OsDebug: os.Getenv("OS_DEBUG") != "",
+			},
+		}
+		return &Config{
+			HwClient:  client,
+			Region:    "cn-north-1",
+			Endpoints: map[string]string{"kms": kms.URL},
+		}, nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  map[string]terraform.ResourceProvider{"huaweicloud": provider},
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsCiphertext_basic("key-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_kms_secrets.secrets", "plaintext.password", "s3cr3t"),
+				),
+			},
+		},
+	})
+
+	for _, action := range []string{"encrypt-data", "decrypt-data"} {
+		if !strings.Contains(buf.String(), action) {
+			t.Fatalf("The %s request was not logged:\n%s", action, buf.String())
+		}
+	}
+	if strings.Contains(buf.String(), "s3cr3t") {
+		t.Fatalf("The plaintext was logged:\n%s", buf.String())
+	}
+}
+
+func TestAccKmsCiphertext_basic(t *testing.T) {
+	keyAlias := fmt.Sprintf("kms_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsCiphertext_withKey(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"huaweicloud_kms_ciphertext.password", "ciphertext_blob"),
+					resource.TestCheckResourceAttr(
+						"data.huaweicloud_kms_secrets.secrets", "plaintext.password", "s3cr3t"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsCiphertext_basic(keyID string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_ciphertext" "password" {
+  key_id    = "%s"
+  plaintext = "s3cr3t"
+
+  encryption_context = {
+    service = "rds"
+  }
+}
+
+data "huaweicloud_kms_secrets" "secrets" {
+  secret {
+    name    = "password"
+    payload = "${huaweicloud_kms_ciphertext.password.ciphertext_blob}"
+
+    context = {
+      service = "rds"
+    }
+  }
+}
+`, keyID)
+}
+
+func testAccKmsCiphertext_withKey(keyAlias string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key_v1" "key_1" {
+  key_alias    = "%s"
+  pending_days = "7"
+}
+%s`, keyAlias, testAccKmsCiphertext_basic("${huaweicloud_kms_key_v1.key_1.id}"))
+}
@@ -0,0 +1,243 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/kms/v1/keys"
+)
+
+func resourceKmsGrantV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsGrantV1Create,
+		Read:   resourceKmsGrantV1Read,
+		Update: resourceKmsGrantV1Update,
+		Delete: resourceKmsGrantV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"user", "domain"})
+				},
+			},
+			"operations": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						return ValidateStringList(v, k, []string{
+							"create-datakey", "create-datakey-without-plaintext",
+							"encrypt-datakey", "decrypt-datakey", "describe-key",
+							"create-grant", "retire-grant", "encrypt-data", "decrypt-data",
+						})
+					},
+				},
+				Set: schema.HashString,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"retiring_principal": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retire_on_delete": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"grant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuing_principal": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsGrantV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud kms key client: %s", err)
+	}
+
+	var operations []string
+	for _, v := range d.Get("operations").(*schema.Set).List() {
+		operations = append(operations, v.(string))
+	}
+
+	createOpts := KmsGrantCreateOpts{
+		KeyID:                d.Get("key_id").(string),
+		GranteePrincipal:     d.Get("grantee_principal").(string),
+		GranteePrincipalType: d.Get("grantee_principal_type").(string),
+		Operations:           operations,
+		Name:                 d.Get("name").(string),
+		RetiringPrincipal:    d.Get("retiring_principal").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	grantID, err := createKmsGrant(kmsKeyV1Client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud grant: %s", err)
+	}
+	log.Printf("[INFO] Grant ID: %s", grantID)
+
+	// Grants can only be looked up through their key.
+	d.SetId(fmt.Sprintf("%s/%s", createOpts.KeyID, grantID))
+
+	return resourceKmsGrantV1Read(d, meta)
+}
+
+func resourceKmsGrantV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud kms key client: %s", err)
+	}
+
+	keyID, grantID, err := parseKmsGrantV1Id(d.Id())
+	if err != nil {
+		return err
+	}
+
+	key, err := keys.Get(kmsKeyV1Client, keyID).ExtractKeyInfo()
+	if err != nil {
+		return CheckDeleted(d, err, "key")
+	}
+	if key.KeyState == PendingDeletionState {
+		log.Printf("[WARN] Removing KMS grant %s because its key %s is pending deletion", d.Id(), keyID)
+		d.SetId("")
+		return nil
+	}
+
+	grant, err := findKmsGrantV1(kmsKeyV1Client, keyID, grantID)
+	if err != nil {
+		return fmt.Errorf("Error listing the grants of key %s: %s", keyID, err)
+	}
+	if grant == nil {
+		// Grants which are revoked or retired are not listed anymore.
+		log.Printf("[WARN] Removing KMS grant %s because it's already gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Kms grant %s: %+v", d.Id(), grant)
+
+	d.Set("key_id", keyID)
+	d.Set("grant_id", grant.GrantID)
+	d.Set("grantee_principal", grant.GranteePrincipal)
+	d.Set("grantee_principal_type", grant.GranteePrincipalType)
+	d.Set("operations", grant.Operations)
+	d.Set("name", grant.Name)
+	d.Set("retiring_principal", grant.RetiringPrincipal)
+	d.Set("issuing_principal", grant.IssuingPrincipal)
+	d.Set("creation_date", grant.CreationDate)
+
+	return nil
+}
+
+// resourceKmsGrantV1Update only stores retire_on_delete, grants themselves
+// cannot be changed.
+func resourceKmsGrantV1Update(d *schema.ResourceData, meta interface{}) error {
+	return resourceKmsGrantV1Read(d, meta)
+}
+
+func resourceKmsGrantV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud kms key client: %s", err)
+	}
+
+	keyID, grantID, err := parseKmsGrantV1Id(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteOpts := KmsGrantDeleteOpts{
+		KeyID:   keyID,
+		GrantID: grantID,
+	}
+
+	if d.Get("retire_on_delete").(bool) {
+		log.Printf("[DEBUG] Retiring KMS grant %s", d.Id())
+		err = retireKmsGrant(kmsKeyV1Client, deleteOpts).ExtractErr()
+	} else {
+		log.Printf("[DEBUG] Revoking KMS grant %s", d.Id())
+		err = revokeKmsGrant(kmsKeyV1Client, deleteOpts).ExtractErr()
+	}
+	if err != nil {
+		return CheckDeleted(d, err, "grant")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseKmsGrantV1Id(id string) (keyID, grantID string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Invalid format specified for KMS grant %q. Format must be <key_id>/<grant_id>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// findKmsGrantV1 pages through the grants of the key and returns the one
+// with the given ID, or nil if there is none.
+func findKmsGrantV1(client *golangsdk.ServiceClient, keyID, grantID string) (*KmsGrant, error) {
+	listOpts := KmsGrantListOpts{
+		KeyID: keyID,
+	}
+	for {
+		l, err := listKmsGrants(client, listOpts).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range l.Grants {
+			if g.GrantID == grantID {
+				return &g, nil
+			}
+		}
+
+		if l.Truncated != "true" || l.NextMarker == "" {
+			return nil, nil
+		}
+		listOpts.Marker = l.NextMarker
+	}
+}
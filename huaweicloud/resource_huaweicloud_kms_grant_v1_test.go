@@ -0,0 +1,198 @@
+package huaweicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk"
+)
+
+func TestFindKmsGrantV1(t *testing.T) {
+	pages := map[string]string{
+		"":  `{"grants":[{"key_id":"key","grant_id":"grant-1"}],"next_marker":"1","truncated":"true"}`,
+		"1": `{"grants":[{"key_id":"key","grant_id":"grant-2","operations":["encrypt-data"]}],"truncated":"false"}`,
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1.0/0123456789abcdef/kms/list-grants" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var opts map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+			t.Errorf("Error decoding request: %s", err)
+		}
+		if opts["key_id"] != "key" {
+			t.Errorf("Unexpected key_id: %s", opts["key_id"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, pages[opts["marker"]])
+	}))
+	defer ts.Close()
+
+	config := &Config{
+		HwClient:  &golangsdk.ProviderClient{ProjectID: "0123456789abcdef"},
+		Endpoints: map[string]string{"kms": ts.URL},
+	}
+	client, err := config.kmsKeyV1Client("cn-north-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	grant, err := findKmsGrantV1(client, "key", "grant-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if grant == nil || grant.GrantID != "grant-2" || len(grant.Operations) != 1 {
+		t.Fatalf("Unexpected grant: %#v", grant)
+	}
+
+	grant, err = findKmsGrantV1(client, "key", "grant-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if grant != nil {
+		t.Fatalf("Expected no grant, got %#v", grant)
+	}
+}
+
+func TestAccKmsGrantV1_basic(t *testing.T) {
+	var grant KmsGrant
+	keyAlias := fmt.Sprintf("kms_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKmsGrant(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsGrantV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsGrantV1_basic(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsGrantV1Exists("huaweicloud_kms_grant_v1.grant_1", &grant),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_kms_grant_v1.grant_1", "grantee_principal", OS_GRANTEE_USER_ID),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_kms_grant_v1.grant_1", "operations.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKmsGrantV1_revoked(t *testing.T) {
+	var grant KmsGrant
+	keyAlias := fmt.Sprintf("kms_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKmsGrant(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsGrantV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsGrantV1_basic(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsGrantV1Exists("huaweicloud_kms_grant_v1.grant_1", &grant),
+					testAccCheckKmsGrantV1Revoke(&grant),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				Config: testAccKmsGrantV1_basic(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsGrantV1Exists("huaweicloud_kms_grant_v1.grant_1", &grant),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKmsGrantV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kmsClient, err := config.kmsKeyV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud kms client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_kms_grant_v1" {
+			continue
+		}
+
+		grant, err := findKmsGrantV1(kmsClient, rs.Primary.Attributes["key_id"], rs.Primary.Attributes["grant_id"])
+		if err == nil && grant != nil {
+			return fmt.Errorf("grant still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKmsGrantV1Exists(n string, grant *KmsGrant) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmsClient, err := config.kmsKeyV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud kms client: %s", err)
+		}
+
+		found, err := findKmsGrantV1(kmsClient, rs.Primary.Attributes["key_id"], rs.Primary.Attributes["grant_id"])
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("grant not found")
+		}
+
+		*grant = *found
+		return nil
+	}
+}
+
+// testAccCheckKmsGrantV1Revoke revokes the grant out-of-band to cause drift.
+func testAccCheckKmsGrantV1Revoke(grant *KmsGrant) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		kmsClient, err := config.kmsKeyV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud kms client: %s", err)
+		}
+
+		return revokeKmsGrant(kmsClient, KmsGrantDeleteOpts{
+			KeyID:   grant.KeyID,
+			GrantID: grant.GrantID,
+		}).ExtractErr()
+	}
+}
+
+func testAccKmsGrantV1_basic(keyAlias string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key_v1" "key_1" {
+  key_alias    = "%s"
+  pending_days = "7"
+}
+
+resource "huaweicloud_kms_grant_v1" "grant_1" {
+  key_id            = "${huaweicloud_kms_key_v1.key_1.id}"
+  grantee_principal = "%s"
+  operations        = ["encrypt-data", "decrypt-data"]
+}
+`, keyAlias, OS_GRANTEE_USER_ID)
+}
@@ -16,6 +16,10 @@ const EnabledState = "2"
 const DisabledState = "3"
 const PendingDeletionState = "4"
 
+// DefaultPendingDays is the deletion window of a key when pending_days is
+// not set, the shortest the service allows.
+const DefaultPendingDays = "7"
+
 func resourceKmsKeyV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceKmsKeyV1Create,
@@ -71,8 +75,20 @@ func resourceKmsKeyV1() *schema.Resource {
 				Computed: true,
 			},
 			"pending_days": &schema.Schema{
-				Type:     schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateKmsKeyPendingDays,
+			},
+			"rotation_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
+			},
+			"rotation_interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateKmsKeyRotationInterval,
 			},
 		},
 	}
@@ -85,52 +101,116 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating HuaweiCloud kms key client: %s", err)
 	}
 
-	createOpts := &keys.CreateOpts{
-		KeyAlias:       d.Get("key_alias").(string),
-		KeyDescription: d.Get("key_description").(string),
-		Realm:          d.Get("realm").(string),
+	if _, ok := d.GetOk("rotation_interval"); ok && !d.Get("rotation_enabled").(bool) {
+		return fmt.Errorf("rotation_interval can only be set when rotation_enabled is true")
 	}
 
-	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	v, err := keys.Create(kmsKeyV1Client, createOpts).ExtractKeyInfo()
+	keyAlias := d.Get("key_alias").(string)
+	pendingKey, err := findKmsKeyV1PendingDeletion(kmsKeyV1Client, keyAlias)
 	if err != nil {
-		return fmt.Errorf("Error creating HuaweiCloud key: %s", err)
+		return fmt.Errorf("Error listing HuaweiCloud keys: %s", err)
 	}
-	log.Printf("[INFO] Key ID: %s", v.KeyID)
 
-	// Wait for the key to become enabled.
-	log.Printf("[DEBUG] Waiting for key (%s) to become enabled", v.KeyID)
+	var keyID string
+	rotationEnabled := d.Get("rotation_enabled").(bool)
+	updateRotation := rotationEnabled
+	if pendingKey != nil {
+		// The alias of a key stays taken until the key is deleted, so
+		// re-creating a destroyed key brings the old one back instead.
+		if realm, ok := d.GetOk("realm"); ok && realm.(string) != pendingKey.Realm {
+			return fmt.Errorf("Error cancelling the deletion of key (%s): its realm %s differs from %s",
+				pendingKey.KeyID, pendingKey.Realm, realm.(string))
+		}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{WaitingForEnableState, DisabledState},
-		Target:     []string{EnabledState},
-		Refresh:    KeyV1StateRefreshFunc(kmsKeyV1Client, v.KeyID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
+		log.Printf("[INFO] Cancelling the scheduled deletion of key (%s) with alias %s", pendingKey.KeyID, keyAlias)
+		v, err := cancelKmsKeyDeletion(kmsKeyV1Client, pendingKey.KeyID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error cancelling the deletion of key (%s): %s", pendingKey.KeyID, err)
+		}
+		if v.KeyState != DisabledState {
+			return fmt.Errorf("Error cancelling the deletion of key (%s), the key state is: %s", pendingKey.KeyID, v.KeyState)
+		}
+		keyID = pendingKey.KeyID
 
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for key (%s) to become ready: %s",
-			v.KeyID, err)
-	}
+		if description := d.Get("key_description").(string); description != pendingKey.KeyDescription {
+			updateDesOpts := keys.UpdateDesOpts{
+				KeyID:          keyID,
+				KeyDescription: description,
+			}
+			_, err = keys.UpdateDes(kmsKeyV1Client, updateDesOpts).ExtractKeyInfo()
+			if err != nil {
+				return fmt.Errorf("Error updating HuaweiCloud key: %s", err)
+			}
+		}
 
-	if !d.Get("is_enabled").(bool) {
-		key, err := keys.DisableKey(kmsKeyV1Client, v.KeyID).ExtractKeyInfo()
+		// The revived key keeps its old rotation settings.
+		r, err := getKmsKeyRotation(kmsKeyV1Client, KmsKeyRotationOpts{KeyID: keyID}).Extract()
 		if err != nil {
-			return fmt.Errorf("Error disabling key: %s.", err)
+			return fmt.Errorf("Error fetching the rotation status of key (%s): %s", keyID, err)
+		}
+		updateRotation = r.Enabled != rotationEnabled
+		if v, ok := d.GetOk("rotation_interval"); ok && rotationEnabled && v.(int) != r.Interval {
+			updateRotation = true
+		}
+	} else {
+		createOpts := &keys.CreateOpts{
+			KeyAlias:       keyAlias,
+			KeyDescription: d.Get("key_description").(string),
+			Realm:          d.Get("realm").(string),
 		}
 
-		if key.KeyState != DisabledState {
-			return fmt.Errorf("Error disabling key, the key state is: %s", key.KeyState)
+		log.Printf("[DEBUG] Create Options: %#v", createOpts)
+		v, err := keys.Create(kmsKeyV1Client, createOpts).ExtractKeyInfo()
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud key: %s", err)
+		}
+		log.Printf("[INFO] Key ID: %s", v.KeyID)
+
+		// Wait for the key to become enabled.
+		log.Printf("[DEBUG] Waiting for key (%s) to become enabled", v.KeyID)
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{WaitingForEnableState, DisabledState},
+			Target:     []string{EnabledState},
+			Refresh:    KeyV1StateRefreshFunc(kmsKeyV1Client, v.KeyID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for key (%s) to become ready: %s",
+				v.KeyID, err)
 		}
+		keyID = v.KeyID
 	}
 
 	// Store the key ID now
-	d.SetId(v.KeyID)
-	d.Set("key_id", v.KeyID)
+	d.SetId(keyID)
+	d.Set("key_id", keyID)
+
+	// Rotation can only be changed while the key is enabled, so enable the
+	// key first and disable it last.
+	isEnabled := d.Get("is_enabled").(bool)
+	if isEnabled || updateRotation {
+		if err := updateKmsKeyV1State(kmsKeyV1Client, keyID, true); err != nil {
+			return err
+		}
+	}
+
+	if updateRotation {
+		if err := updateKmsKeyV1Rotation(kmsKeyV1Client, d); err != nil {
+			return err
+		}
+	}
+
+	if !isEnabled {
+		if err := updateKmsKeyV1State(kmsKeyV1Client, keyID, false); err != nil {
+			return err
+		}
+	}
 
 	return resourceKmsKeyV1Read(d, meta)
 }
@@ -166,6 +246,13 @@ func resourceKmsKeyV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("default_key_flag", v.DefaultKeyFlag)
 	d.Set("expiration_time", v.ExpirationTime)
 
+	r, err := getKmsKeyRotation(kmsKeyV1Client, KmsKeyRotationOpts{KeyID: v.KeyID}).Extract()
+	if err != nil {
+		return fmt.Errorf("Error getting the rotation status of key (%s): %s", v.KeyID, err)
+	}
+	d.Set("rotation_enabled", r.Enabled)
+	d.Set("rotation_interval", r.Interval)
+
 	return nil
 }
 
@@ -176,6 +263,10 @@ func resourceKmsKeyV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating HuaweiCloud kms key client: %s", err)
 	}
 
+	if d.HasChange("rotation_interval") && !d.Get("rotation_enabled").(bool) {
+		return fmt.Errorf("rotation_interval can only be set when rotation_enabled is true")
+	}
+
 	if d.HasChange("key_alias") {
 		updateAliasOpts := keys.UpdateAliasOpts{
 			KeyID:    d.Id(),
@@ -198,30 +289,24 @@ func resourceKmsKeyV1Update(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("is_enabled") {
-		v, err := keys.Get(kmsKeyV1Client, d.Id()).ExtractKeyInfo()
-		if err != nil {
-			return fmt.Errorf("DescribeKey got an error: %s.", err)
+	// Rotation can only be changed while the key is enabled, so enable the
+	// key first and disable it last.
+	isEnabled := d.Get("is_enabled").(bool)
+	if d.HasChange("is_enabled") && isEnabled {
+		if err := updateKmsKeyV1State(kmsKeyV1Client, d.Id(), true); err != nil {
+			return err
 		}
+	}
 
-		if d.Get("is_enabled").(bool) && v.KeyState == DisabledState {
-			key, err := keys.EnableKey(kmsKeyV1Client, d.Id()).ExtractKeyInfo()
-			if err != nil {
-				return fmt.Errorf("Error enabling key: %s.", err)
-			}
-			if key.KeyState != EnabledState {
-				return fmt.Errorf("Error enabling key, the key state is: %s", key.KeyState)
-			}
+	if d.HasChange("rotation_enabled") || d.HasChange("rotation_interval") {
+		if err := updateKmsKeyV1Rotation(kmsKeyV1Client, d); err != nil {
+			return err
 		}
+	}
 
-		if !d.Get("is_enabled").(bool) && v.KeyState == EnabledState {
-			key, err := keys.DisableKey(kmsKeyV1Client, d.Id()).ExtractKeyInfo()
-			if err != nil {
-				return fmt.Errorf("Error disabling key: %s.", err)
-			}
-			if key.KeyState != DisabledState {
-				return fmt.Errorf("Error disabling key, the key state is: %s", key.KeyState)
-			}
+	if d.HasChange("is_enabled") && !isEnabled {
+		if err := updateKmsKeyV1State(kmsKeyV1Client, d.Id(), false); err != nil {
+			return err
 		}
 	}
 
@@ -245,6 +330,8 @@ func resourceKmsKeyV1Delete(d *schema.ResourceData, meta interface{}) error {
 	}
 	if v, ok := d.GetOk("pending_days"); ok {
 		deleteOpts.PendingDays = v.(string)
+	} else {
+		deleteOpts.PendingDays = DefaultPendingDays
 	}
 
 	// It's possible that this key was used as a boot device and is currently
@@ -279,3 +366,92 @@ func KeyV1StateRefreshFunc(client *golangsdk.ServiceClient, keyID string) resour
 		return v, v.KeyState, nil
 	}
 }
+
+// updateKmsKeyV1State enables or disables the key unless it is already in
+// the requested state.
+func updateKmsKeyV1State(client *golangsdk.ServiceClient, keyID string, enabled bool) error {
+	v, err := keys.Get(client, keyID).ExtractKeyInfo()
+	if err != nil {
+		return fmt.Errorf("DescribeKey got an error: %s.", err)
+	}
+
+	if enabled && v.KeyState == DisabledState {
+		key, err := keys.EnableKey(client, keyID).ExtractKeyInfo()
+		if err != nil {
+			return fmt.Errorf("Error enabling key: %s.", err)
+		}
+		if key.KeyState != EnabledState {
+			return fmt.Errorf("Error enabling key, the key state is: %s", key.KeyState)
+		}
+	}
+
+	if !enabled && v.KeyState == EnabledState {
+		key, err := keys.DisableKey(client, keyID).ExtractKeyInfo()
+		if err != nil {
+			return fmt.Errorf("Error disabling key: %s.", err)
+		}
+		if key.KeyState != DisabledState {
+			return fmt.Errorf("Error disabling key, the key state is: %s", key.KeyState)
+		}
+	}
+
+	return nil
+}
+
+// updateKmsKeyV1Rotation turns the automatic rotation of the key on or off
+// and sets its interval.
+func updateKmsKeyV1Rotation(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	rotationOpts := KmsKeyRotationOpts{
+		KeyID: d.Id(),
+	}
+
+	if !d.Get("rotation_enabled").(bool) {
+		log.Printf("[DEBUG] Disabling the rotation of key (%s)", d.Id())
+		if err := disableKmsKeyRotation(client, rotationOpts).ExtractErr(); err != nil {
+			return fmt.Errorf("Error disabling the rotation of key (%s): %s", d.Id(), err)
+		}
+		return nil
+	}
+
+	if d.HasChange("rotation_enabled") || d.IsNewResource() {
+		log.Printf("[DEBUG] Enabling the rotation of key (%s)", d.Id())
+		if err := enableKmsKeyRotation(client, rotationOpts).ExtractErr(); err != nil {
+			return fmt.Errorf("Error enabling the rotation of key (%s): %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("rotation_interval"); ok {
+		rotationOpts.Interval = v.(int)
+		log.Printf("[DEBUG] Updating the rotation interval of key (%s) to %d days", d.Id(), rotationOpts.Interval)
+		if err := updateKmsKeyRotationInterval(client, rotationOpts).ExtractErr(); err != nil {
+			return fmt.Errorf("Error updating the rotation interval of key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// findKmsKeyV1PendingDeletion returns the key with the given alias which is
+// scheduled for deletion, or nil if there is none.
+func findKmsKeyV1PendingDeletion(client *golangsdk.ServiceClient, keyAlias string) (*keys.Key, error) {
+	listOpts := keys.ListOpts{
+		KeyState: PendingDeletionState,
+	}
+	for {
+		l, err := keys.List(client, listOpts).ExtractListKey()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range l.KeyDetails {
+			if k.KeyAlias == keyAlias {
+				return &k, nil
+			}
+		}
+
+		if l.Truncated != "true" || l.NextMarker == "" {
+			return nil, nil
+		}
+		listOpts.Marker = l.NextMarker
+	}
+}
@@ -2,6 +2,7 @@ package huaweicloud
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -131,6 +132,152 @@ func TestAccKmsKey_isEnabled(t *testing.T) {
 	})
 }
 
+func TestAccKmsKey_isEnabledDrift(t *testing.T) {
+	var key keys.Key
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKey_enabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("huaweicloud_kms_key_v1.bar", &key),
+					testAccCheckKmsKeyDisable(&key),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccKmsKey_enabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("huaweicloud_kms_key_v1.bar", &key),
+					resource.TestCheckResourceAttr("huaweicloud_kms_key_v1.bar", "is_enabled", "true"),
+					testAccCheckKmsKeyIsEnabled(&key, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKmsKey_rotation(t *testing.T) {
+	var key keys.Key
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKey_rotation(rName, 365),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("huaweicloud_kms_key_v1.bar", &key),
+					resource.TestCheckResourceAttr("huaweicloud_kms_key_v1.bar", "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr("huaweicloud_kms_key_v1.bar", "rotation_interval", "365"),
+				),
+			},
+			{
+				Config: testAccKmsKey_rotation(rName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("huaweicloud_kms_key_v1.bar", &key),
+					resource.TestCheckResourceAttr("huaweicloud_kms_key_v1.bar", "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr("huaweicloud_kms_key_v1.bar", "rotation_interval", "90"),
+				),
+			},
+			{
+				Config: testAccKmsKey_enabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("huaweicloud_kms_key_v1.bar", &key),
+					resource.TestCheckResourceAttr("huaweicloud_kms_key_v1.bar", "rotation_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKmsKey_rotationIntervalWithoutRotation(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKmsKey_rotationInterval(rName),
+				ExpectError: regexp.MustCompile("rotation_interval can only be set when rotation_enabled is true"),
+			},
+		},
+	})
+}
+
+func TestAccKmsKey_cancelDeletion(t *testing.T) {
+	var key1, key2 keys.Key
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKey_enabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("huaweicloud_kms_key_v1.bar", &key1),
+				),
+			},
+			{
+				Config:  testAccKmsKey_enabled(rName),
+				Destroy: true,
+			},
+			{
+				Config: testAccKmsKey_enabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("huaweicloud_kms_key_v1.bar", &key2),
+					resource.TestCheckResourceAttr("huaweicloud_kms_key_v1.bar", "is_enabled", "true"),
+					testAccCheckKmsKeyIsEnabled(&key2, true),
+					testAccCheckKmsKeySame(&key1, &key2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKmsKey_cancelDeletionRotation(t *testing.T) {
+	var key1, key2 keys.Key
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKey_rotation(rName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("huaweicloud_kms_key_v1.bar", &key1),
+				),
+			},
+			{
+				Config:  testAccKmsKey_rotation(rName, 90),
+				Destroy: true,
+			},
+			{
+				Config: testAccKmsKey_disabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("huaweicloud_kms_key_v1.bar", &key2),
+					testAccCheckKmsKeySame(&key1, &key2),
+					testAccCheckKmsKeyIsEnabled(&key2, false),
+					resource.TestCheckResourceAttr("huaweicloud_kms_key_v1.bar", "is_enabled", "false"),
+					resource.TestCheckResourceAttr("huaweicloud_kms_key_v1.bar", "rotation_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKmsKeyIsEnabled(key *keys.Key, isEnabled bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if (key.KeyState == EnabledState) != isEnabled {
@@ -142,6 +289,30 @@ func testAccCheckKmsKeyIsEnabled(key *keys.Key, isEnabled bool) resource.TestChe
 	}
 }
 
+// testAccCheckKmsKeyDisable disables the key out-of-band to cause drift.
+func testAccCheckKmsKeyDisable(key *keys.Key) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		kmsClient, err := config.kmsKeyV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud kms client: %s", err)
+		}
+
+		_, err = keys.DisableKey(kmsClient, key.KeyID).ExtractKeyInfo()
+		return err
+	}
+}
+
+func testAccCheckKmsKeySame(key1, key2 *keys.Key) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if key1.KeyID != key2.KeyID {
+			return fmt.Errorf("Expected key %s to be restored, got key %s", key1.KeyID, key2.KeyID)
+		}
+
+		return nil
+	}
+}
+
 func testAccKmsV1Key_basic(keyAlias string) string {
 	return fmt.Sprintf(`
 		resource "huaweicloud_kms_key_v1" "key_2" {
@@ -179,3 +350,24 @@ resource "huaweicloud_kms_key_v1" "bar" {
     is_enabled      = false
 }`, rName, rName)
 }
+
+func testAccKmsKey_rotation(rName string, interval int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key_v1" "bar" {
+    key_description   = "Terraform acc test is_enabled %s"
+    pending_days      = "7"
+    key_alias         = "tf-acc-test-kms-key-%s"
+    rotation_enabled  = true
+    rotation_interval = %d
+}`, rName, rName, interval)
+}
+
+func testAccKmsKey_rotationInterval(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key_v1" "bar" {
+    key_description   = "Terraform acc test is_enabled %s"
+    pending_days      = "7"
+    key_alias         = "tf-acc-test-kms-key-%s"
+    rotation_interval = 90
+}`, rName, rName)
+}
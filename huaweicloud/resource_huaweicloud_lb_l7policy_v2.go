@@ -0,0 +1,305 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func resourceL7PolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7PolicyV2Create,
+		Read:   resourceL7PolicyV2Read,
+		Update: resourceL7PolicyV2Update,
+		Delete: resourceL7PolicyV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"action": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{
+						string(l7policies.ActionRedirectToPool),
+						string(l7policies.ActionRedirectToURL),
+						string(l7policies.ActionReject),
+					})
+				},
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"position": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"redirect_pool_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"redirect_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceL7PolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	action := d.Get("action").(string)
+	redirectPoolID := d.Get("redirect_pool_id").(string)
+	redirectURL := d.Get("redirect_url").(string)
+	if err := checkL7PolicyV2Action(action, redirectPoolID, redirectURL); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := l7policies.CreateOpts{
+		TenantID:       d.Get("tenant_id").(string),
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Action:         l7policies.Action(action),
+		ListenerID:     d.Get("listener_id").(string),
+		Position:       int32(d.Get("position").(int)),
+		RedirectPoolID: redirectPoolID,
+		RedirectURL:    redirectURL,
+		AdminStateUp:   &adminStateUp,
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	listenerID := createOpts.ListenerID
+	err = waitForLBV2viaListener(lbClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	log.Printf("[DEBUG] Attempting to create L7 Policy")
+	var l7Policy *l7policies.L7Policy
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7Policy, err = l7policies.Create(lbClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to create L7 Policy: %s", err)
+	}
+
+	err = waitForLBV2viaListener(lbClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(l7Policy.ID)
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	l7Policy, err := l7policies.Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "L7 Policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved L7 Policy %s: %#v", d.Id(), l7Policy)
+
+	d.Set("tenant_id", l7Policy.TenantID)
+	d.Set("name", l7Policy.Name)
+	d.Set("description", l7Policy.Description)
+	d.Set("action", l7Policy.Action)
+	d.Set("listener_id", l7Policy.ListenerID)
+	d.Set("position", int(l7Policy.Position))
+	d.Set("redirect_pool_id", l7Policy.RedirectPoolID)
+	d.Set("redirect_url", l7Policy.RedirectURL)
+	d.Set("admin_state_up", l7Policy.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7PolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	action := d.Get("action").(string)
+	redirectPoolID := d.Get("redirect_pool_id").(string)
+	redirectURL := d.Get("redirect_url").(string)
+	if err := checkL7PolicyV2Action(action, redirectPoolID, redirectURL); err != nil {
+		return err
+	}
+
+	var updateOpts l7policies.UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("action") {
+		updateOpts.Action = l7policies.Action(action)
+	}
+	if d.HasChange("position") {
+		updateOpts.Position = int32(d.Get("position").(int))
+	}
+	if d.HasChange("redirect_pool_id") {
+		updateOpts.RedirectPoolID = &redirectPoolID
+	}
+	if d.HasChange("redirect_url") {
+		updateOpts.RedirectURL = &redirectURL
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	log.Printf("[DEBUG] Updating L7 Policy %s with options: %#v", d.Id(), updateOpts)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(lbClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err = l7policies.Update(lbClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to update L7 Policy %s: %s", d.Id(), err)
+	}
+
+	// Wait for LB to become active before continuing
+	err = waitForLBV2viaListener(lbClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting L7 Policy %s", d.Id())
+	timeout := d.Timeout(schema.TimeoutDelete)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(lbClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = l7policies.Delete(lbClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to delete L7 Policy %s: %s", d.Id(), err)
+	}
+
+	err = waitForLBV2viaListener(lbClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkL7PolicyV2Action makes sure that exactly the redirect target needed
+// by the action is set, the API rejects the other combinations.
+func checkL7PolicyV2Action(action, redirectPoolID, redirectURL string) error {
+	switch l7policies.Action(action) {
+	case l7policies.ActionRedirectToPool:
+		if redirectPoolID == "" || redirectURL != "" {
+			return fmt.Errorf("redirect_pool_id must be set and redirect_url must be empty when action is %s", action)
+		}
+	case l7policies.ActionRedirectToURL:
+		if redirectURL == "" || redirectPoolID != "" {
+			return fmt.Errorf("redirect_url must be set and redirect_pool_id must be empty when action is %s", action)
+		}
+	case l7policies.ActionReject:
+		if redirectPoolID != "" || redirectURL != "" {
+			return fmt.Errorf("redirect_pool_id and redirect_url must be empty when action is %s", action)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCheckL7PolicyV2Action(t *testing.T) {
+	cases := []struct {
+		action, redirectPoolID, redirectURL string
+		valid                               bool
+	}{
+		{"REDIRECT_TO_POOL", "pool", "", true},
+		{"REDIRECT_TO_POOL", "", "", false},
+		{"REDIRECT_TO_POOL", "pool", "http://www.example.com", false},
+		{"REDIRECT_TO_URL", "", "http://www.example.com", true},
+		{"REDIRECT_TO_URL", "", "", false},
+		{"REDIRECT_TO_URL", "pool", "http://www.example.com", false},
+		{"REJECT", "", "", true},
+		{"REJECT", "pool", "", false},
+	}
+
+	for _, tc := range cases {
+		err := checkL7PolicyV2Action(tc.action, tc.redirectPoolID, tc.redirectURL)
+		if tc.valid && err != nil {
+			t.Fatalf("%s with pool %q and url %q: unexpected error: %s",
+				tc.action, tc.redirectPoolID, tc.redirectURL, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("%s with pool %q and url %q: expected an error",
+				tc.action, tc.redirectPoolID, tc.redirectURL)
+		}
+	}
+}
+
+func TestAccLBV2L7Policy_basic(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckULB(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2L7PolicyConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("huaweicloud_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_URL"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7policy_v2.l7policy_1", "redirect_url", "http://www.example.com"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2L7PolicyConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7policy_v2.l7policy_1", "name", "l7policy_1_updated"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7policy_v2.l7policy_1", "redirect_url", ""),
+					resource.TestCheckResourceAttrPair(
+						"huaweicloud_lb_l7policy_v2.l7policy_1", "redirect_pool_id",
+						"huaweicloud_lb_pool_v2.pool_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_lb_l7policy_v2" {
+			continue
+		}
+
+		_, err := l7policies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("L7 Policy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2L7PolicyExists(n string, l7Policy *l7policies.L7Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+		}
+
+		found, err := l7policies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L7 Policy not found")
+		}
+
+		*l7Policy = *found
+
+		return nil
+	}
+}
+
+const testAccLBV2L7PolicyConfig_listener = `
+resource "huaweicloud_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "huaweicloud_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${huaweicloud_networking_network_v2.network_1.id}"
+}
+
+resource "huaweicloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${huaweicloud_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "huaweicloud_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${huaweicloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "huaweicloud_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${huaweicloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+`
+
+var TestAccLBV2L7PolicyConfig_basic = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1"
+  action = "REDIRECT_TO_URL"
+  description = "test description"
+  position = 1
+  listener_id = "${huaweicloud_lb_listener_v2.listener_1.id}"
+  redirect_url = "http://www.example.com"
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, testAccLBV2L7PolicyConfig_listener)
+
+var TestAccLBV2L7PolicyConfig_update = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1_updated"
+  action = "REDIRECT_TO_POOL"
+  description = "test description"
+  position = 1
+  listener_id = "${huaweicloud_lb_listener_v2.listener_1.id}"
+  redirect_pool_id = "${huaweicloud_lb_pool_v2.pool_1.id}"
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, testAccLBV2L7PolicyConfig_listener)
@@ -0,0 +1,311 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func resourceL7RuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7RuleV2Create,
+		Read:   resourceL7RuleV2Read,
+		Update: resourceL7RuleV2Update,
+		Delete: resourceL7RuleV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"l7policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{
+						string(l7policies.TypeCookie),
+						string(l7policies.TypeFileType),
+						string(l7policies.TypeHeader),
+						string(l7policies.TypeHostName),
+						string(l7policies.TypePath),
+					})
+				},
+			},
+
+			"compare_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{
+						string(l7policies.CompareTypeContains),
+						string(l7policies.CompareTypeEndWith),
+						string(l7policies.CompareTypeEqual),
+						string(l7policies.CompareTypeRegex),
+						string(l7policies.CompareTypeStartWith),
+					})
+				},
+			},
+
+			"value": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"invert": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceL7RuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	ruleType := d.Get("type").(string)
+	key := d.Get("key").(string)
+	if err := checkL7RuleV2Key(ruleType, key); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := l7policies.CreateRuleOpts{
+		TenantID:     d.Get("tenant_id").(string),
+		RuleType:     l7policies.RuleType(ruleType),
+		CompareType:  l7policies.CompareType(d.Get("compare_type").(string)),
+		Value:        d.Get("value").(string),
+		Key:          key,
+		Invert:       d.Get("invert").(bool),
+		AdminStateUp: &adminStateUp,
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	l7policyID := d.Get("l7policy_id").(string)
+	err = waitForLBV2viaL7Policy(lbClient, l7policyID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	log.Printf("[DEBUG] Attempting to create L7 Rule")
+	var l7Rule *l7policies.Rule
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7Rule, err = l7policies.CreateRule(lbClient, l7policyID, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to create L7 Rule: %s", err)
+	}
+
+	err = waitForLBV2viaL7Policy(lbClient, l7policyID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(l7Rule.ID)
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	l7Policy, err := l7policies.Get(lbClient, l7policyID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "L7 Policy")
+	}
+
+	l7Rule, err := l7policies.GetRule(lbClient, l7policyID, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "L7 Rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved L7 Rule %s: %#v", d.Id(), l7Rule)
+
+	d.Set("l7policy_id", l7policyID)
+	d.Set("listener_id", l7Policy.ListenerID)
+	d.Set("tenant_id", l7Rule.TenantID)
+	d.Set("type", l7Rule.RuleType)
+	d.Set("compare_type", l7Rule.CompareType)
+	d.Set("value", l7Rule.Value)
+	d.Set("key", l7Rule.Key)
+	d.Set("invert", l7Rule.Invert)
+	d.Set("admin_state_up", l7Rule.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7RuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	ruleType := d.Get("type").(string)
+	key := d.Get("key").(string)
+	if err := checkL7RuleV2Key(ruleType, key); err != nil {
+		return err
+	}
+
+	var updateOpts l7policies.UpdateRuleOpts
+	if d.HasChange("type") {
+		updateOpts.RuleType = l7policies.RuleType(ruleType)
+	}
+	if d.HasChange("compare_type") {
+		updateOpts.CompareType = l7policies.CompareType(d.Get("compare_type").(string))
+	}
+	if d.HasChange("value") {
+		updateOpts.Value = d.Get("value").(string)
+	}
+	if d.HasChange("key") {
+		updateOpts.Key = &key
+	}
+	if d.HasChange("invert") {
+		invert := d.Get("invert").(bool)
+		updateOpts.Invert = &invert
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	log.Printf("[DEBUG] Updating L7 Rule %s with options: %#v", d.Id(), updateOpts)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	l7policyID := d.Get("l7policy_id").(string)
+	err = waitForLBV2viaL7Policy(lbClient, l7policyID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err = l7policies.UpdateRule(lbClient, l7policyID, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to update L7 Rule %s: %s", d.Id(), err)
+	}
+
+	// Wait for LB to become active before continuing
+	err = waitForLBV2viaL7Policy(lbClient, l7policyID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting L7 Rule %s", d.Id())
+	timeout := d.Timeout(schema.TimeoutDelete)
+	l7policyID := d.Get("l7policy_id").(string)
+	err = waitForLBV2viaL7Policy(lbClient, l7policyID, "ACTIVE", timeout)
+	if err != nil {
+		// The rules are deleted together with their policy.
+		return CheckDeleted(d, err, "L7 Policy")
+	}
+
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = l7policies.DeleteRule(lbClient, l7policyID, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to delete L7 Rule %s: %s", d.Id(), err)
+	}
+
+	err = waitForLBV2viaL7Policy(lbClient, l7policyID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkL7RuleV2Key makes sure that key is only set, and always set, for the
+// rule types matching a named cookie or header.
+func checkL7RuleV2Key(ruleType, key string) error {
+	switch l7policies.RuleType(ruleType) {
+	case l7policies.TypeCookie, l7policies.TypeHeader:
+		if key == "" {
+			return fmt.Errorf("key must be set when type is %s", ruleType)
+		}
+	default:
+		if key != "" {
+			return fmt.Errorf("key must be empty when type is %s", ruleType)
+		}
+	}
+
+	return nil
+}
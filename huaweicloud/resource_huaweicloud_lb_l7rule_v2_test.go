@@ -0,0 +1,174 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCheckL7RuleV2Key(t *testing.T) {
+	cases := []struct {
+		ruleType, key string
+		valid         bool
+	}{
+		{"HOST_NAME", "", true},
+		{"HOST_NAME", "X-Host", false},
+		{"PATH", "", true},
+		{"HEADER", "X-Forwarded-Proto", true},
+		{"HEADER", "", false},
+		{"COOKIE", "session", true},
+		{"COOKIE", "", false},
+	}
+
+	for _, tc := range cases {
+		err := checkL7RuleV2Key(tc.ruleType, tc.key)
+		if tc.valid && err != nil {
+			t.Fatalf("%s with key %q: unexpected error: %s", tc.ruleType, tc.key, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("%s with key %q: expected an error", tc.ruleType, tc.key)
+		}
+	}
+}
+
+func TestAccLBV2L7Rule_basic(t *testing.T) {
+	var l7Rule l7policies.Rule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckULB(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7RuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2L7RuleConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7RuleExists("huaweicloud_lb_l7rule_v2.l7rule_1", &l7Rule),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7rule_v2.l7rule_1", "type", "HOST_NAME"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7rule_v2.l7rule_1", "compare_type", "EQUAL_TO"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7rule_v2.l7rule_1", "value", "www.example.com"),
+					resource.TestCheckResourceAttrPair(
+						"huaweicloud_lb_l7rule_v2.l7rule_1", "listener_id",
+						"huaweicloud_lb_listener_v2.listener_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2L7RuleConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7rule_v2.l7rule_1", "type", "PATH"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7rule_v2.l7rule_1", "compare_type", "STARTS_WITH"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_lb_l7rule_v2.l7rule_1", "value", "/api"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2L7RuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_lb_l7rule_v2" {
+			continue
+		}
+
+		l7policyID := rs.Primary.Attributes["l7policy_id"]
+		_, err := l7policies.GetRule(networkingClient, l7policyID, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("L7 Rule still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2L7RuleExists(n string, l7Rule *l7policies.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+		}
+
+		l7policyID := rs.Primary.Attributes["l7policy_id"]
+		found, err := l7policies.GetRule(networkingClient, l7policyID, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L7 Rule not found")
+		}
+
+		*l7Rule = *found
+
+		return nil
+	}
+}
+
+var testAccLBV2L7RuleConfig_policy = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1"
+  action = "REDIRECT_TO_POOL"
+  position = 1
+  listener_id = "${huaweicloud_lb_listener_v2.listener_1.id}"
+  redirect_pool_id = "${huaweicloud_lb_pool_v2.pool_1.id}"
+}
+`, testAccLBV2L7PolicyConfig_listener)
+
+var TestAccLBV2L7RuleConfig_basic = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id = "${huaweicloud_lb_l7policy_v2.l7policy_1.id}"
+  type = "HOST_NAME"
+  compare_type = "EQUAL_TO"
+  value = "www.example.com"
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, testAccLBV2L7RuleConfig_policy)
+
+var TestAccLBV2L7RuleConfig_update = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id = "${huaweicloud_lb_l7policy_v2.l7policy_1.id}"
+  type = "PATH"
+  compare_type = "STARTS_WITH"
+  value = "/api"
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, testAccLBV2L7RuleConfig_policy)
@@ -0,0 +1,233 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+func resourceNatDnatRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNatDnatRuleV2Create,
+		Read:   resourceNatDnatRuleV2Read,
+		Delete: resourceNatDnatRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"nat_gateway_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"floating_ip_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"tcp", "udp", "any"})
+				},
+			},
+			"internal_service_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"external_service_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"port_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"private_ip"},
+			},
+			"private_ip": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"port_id"},
+			},
+			"floating_ip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNatDnatRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	natV2Client, err := config.natV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud nat client: %s", err)
+	}
+
+	portID := d.Get("port_id").(string)
+	privateIP := d.Get("private_ip").(string)
+	if portID == "" && privateIP == "" {
+		return fmt.Errorf("One of port_id or private_ip must be set")
+	}
+
+	internalServicePort := d.Get("internal_service_port").(int)
+	externalServicePort := d.Get("external_service_port").(int)
+	createOpts := DnatRuleCreateOpts{
+		NatGatewayID:        d.Get("nat_gateway_id").(string),
+		PortID:              portID,
+		PrivateIp:           privateIP,
+		InternalServicePort: &internalServicePort,
+		FloatingIPID:        d.Get("floating_ip_id").(string),
+		ExternalServicePort: &externalServicePort,
+		Protocol:            d.Get("protocol").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	dnatRule, err := createDnatRule(natV2Client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating Dnat Rule: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud Dnat Rule (%s) to become available.", dnatRule.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForDnatRuleActive(natV2Client, dnatRule.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud Dnat Rule: %s", err)
+	}
+
+	d.SetId(dnatRule.ID)
+
+	return resourceNatDnatRuleV2Read(d, meta)
+}
+
+func resourceNatDnatRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	natV2Client, err := config.natV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud nat client: %s", err)
+	}
+
+	dnatRule, err := getDnatRule(natV2Client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Dnat Rule")
+	}
+
+	d.Set("nat_gateway_id", dnatRule.NatGatewayID)
+	d.Set("floating_ip_id", dnatRule.FloatingIPID)
+	d.Set("protocol", dnatRule.Protocol)
+	d.Set("internal_service_port", dnatRule.InternalServicePort)
+	d.Set("external_service_port", dnatRule.ExternalServicePort)
+	d.Set("port_id", dnatRule.PortID)
+	d.Set("private_ip", dnatRule.PrivateIp)
+	d.Set("floating_ip_address", dnatRule.FloatingIPAddress)
+	d.Set("status", dnatRule.Status)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNatDnatRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	natV2Client, err := config.natV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud nat client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForDnatRuleDelete(natV2Client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud Dnat Rule: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForDnatRuleActive(natV2Client *golangsdk.ServiceClient, nId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := getDnatRule(natV2Client, nId).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud Dnat Rule: %+v", n)
+		if n.Status == "ACTIVE" {
+			return n, "ACTIVE", nil
+		}
+
+		return n, "", nil
+	}
+}
+
+func waitForDnatRuleDelete(natV2Client *golangsdk.ServiceClient, nId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete HuaweiCloud Dnat Rule %s.\n", nId)
+
+		n, err := getDnatRule(natV2Client, nId).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud Dnat Rule %s", nId)
+				return n, "DELETED", nil
+			}
+			return n, "ACTIVE", err
+		}
+
+		err = deleteDnatRule(natV2Client, nId).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud Dnat Rule %s", nId)
+				return n, "DELETED", nil
+			}
+			return n, "ACTIVE", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud Dnat Rule %s still active.\n", nId)
+		return n, "ACTIVE", nil
+	}
+}
@@ -0,0 +1,185 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+)
+
+func TestAccNatDnatRule_basic(t *testing.T) {
+	var fip floatingips.FloatingIP
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNatV2DnatRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNatV2DnatRule_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists("huaweicloud_networking_floatingip_v2.fip_1", &fip),
+					testAccCheckNetworkingV2PortExists("huaweicloud_networking_port_v2.port_1", &port),
+					testAccCheckNatV2GatewayExists("huaweicloud_nat_gateway_v2.nat_1"),
+					testAccCheckNatV2DnatRuleExists("huaweicloud_nat_dnat_rule_v2.dnat_1"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_nat_dnat_rule_v2.dnat_1", "protocol", "tcp"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_nat_dnat_rule_v2.dnat_1", "internal_service_port", "80"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_nat_dnat_rule_v2.dnat_1", "external_service_port", "8080"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_nat_dnat_rule_v2.dnat_1", "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNatDnatRule_wholeIP(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNatV2DnatRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNatV2DnatRule_wholeIP,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatV2DnatRuleExists("huaweicloud_nat_dnat_rule_v2.dnat_1"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_nat_dnat_rule_v2.dnat_1", "protocol", "any"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_nat_dnat_rule_v2.dnat_1", "private_ip", "192.168.199.30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNatV2DnatRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	natClient, err := config.natV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud nat client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_nat_dnat_rule_v2" {
+			continue
+		}
+
+		_, err := getDnatRule(natClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Dnat rule still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNatV2DnatRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		natClient, err := config.natV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud nat client: %s", err)
+		}
+
+		found, err := getDnatRule(natClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Dnat rule not found")
+		}
+
+		return nil
+	}
+}
+
+const testAccNatV2DnatRule_base = `
+resource "huaweicloud_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "huaweicloud_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "huaweicloud_networking_subnet_v2" "subnet_1" {
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${huaweicloud_networking_network_v2.network_1.id}"
+}
+
+resource "huaweicloud_networking_router_interface_v2" "int_1" {
+  subnet_id = "${huaweicloud_networking_subnet_v2.subnet_1.id}"
+  router_id = "${huaweicloud_networking_router_v2.router_1.id}"
+}
+
+resource "huaweicloud_networking_floatingip_v2" "fip_1" {
+}
+
+resource "huaweicloud_nat_gateway_v2" "nat_1" {
+  name   = "nat_1"
+  description = "test for terraform"
+  spec = "1"
+  internal_network_id = "${huaweicloud_networking_network_v2.network_1.id}"
+  router_id = "${huaweicloud_networking_router_v2.router_1.id}"
+  depends_on = ["huaweicloud_networking_router_interface_v2.int_1"]
+}
+`
+
+var testAccNatV2DnatRule_basic = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${huaweicloud_networking_network_v2.network_1.id}"
+
+  fixed_ip {
+    subnet_id =  "${huaweicloud_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+}
+
+resource "huaweicloud_nat_dnat_rule_v2" "dnat_1" {
+  nat_gateway_id = "${huaweicloud_nat_gateway_v2.nat_1.id}"
+  floating_ip_id = "${huaweicloud_networking_floatingip_v2.fip_1.id}"
+  port_id = "${huaweicloud_networking_port_v2.port_1.id}"
+  protocol = "tcp"
+  internal_service_port = 80
+  external_service_port = 8080
+}
+`, testAccNatV2DnatRule_base)
+
+var testAccNatV2DnatRule_wholeIP = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_nat_dnat_rule_v2" "dnat_1" {
+  nat_gateway_id = "${huaweicloud_nat_gateway_v2.nat_1.id}"
+  floating_ip_id = "${huaweicloud_networking_floatingip_v2.fip_1.id}"
+  private_ip = "192.168.199.30"
+  protocol = "any"
+  internal_service_port = 0
+  external_service_port = 0
+}
+`, testAccNatV2DnatRule_base)
@@ -45,6 +45,25 @@ func TestAccNatGateway_basic(t *testing.T) {
 	})
 }
 
+func TestAccNatGateway_vpcV1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNatV2GatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNatV2Gateway_vpcV1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatV2GatewayExists("huaweicloud_nat_gateway_v2.nat_1"),
+					resource.TestCheckResourceAttrPair(
+						"huaweicloud_nat_gateway_v2.nat_1", "router_id",
+						"huaweicloud_vpc_v1.vpc_1", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNatV2GatewayDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	natClient, err := config.natV2Client(OS_REGION_NAME)
@@ -159,3 +178,25 @@ resource "huaweicloud_nat_gateway_v2" "nat_1" {
   depends_on = ["huaweicloud_networking_router_interface_v2.int_1"]
 }
 `
+
+const testAccNatV2Gateway_vpcV1 = `
+resource "huaweicloud_vpc_v1" "vpc_1" {
+  name = "vpc_1"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet_v1" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  gateway_ip = "192.168.199.1"
+  vpc_id = "${huaweicloud_vpc_v1.vpc_1.id}"
+}
+
+resource "huaweicloud_nat_gateway_v2" "nat_1" {
+  name   = "nat_1"
+  description = "test for terraform"
+  spec = "1"
+  router_id = "${huaweicloud_vpc_v1.vpc_1.id}"
+  internal_network_id = "${huaweicloud_vpc_subnet_v1.subnet_1.id}"
+}
+`
@@ -0,0 +1,231 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceObsBucket() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObsBucketCreate,
+		Read:   resourceObsBucketRead,
+		Update: resourceObsBucketUpdate,
+		Delete: resourceObsBucketDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "STANDARD",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"STANDARD", "WARM", "COLD"})
+				},
+			},
+			"acl": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "private",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{
+						"private", "public-read", "public-read-write",
+						"public-read-delivered", "public-read-write-delivered",
+					})
+				},
+			},
+			"policy": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"multi_az": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"quota": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"bucket_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceObsBucketCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	obsClient, err := config.computeOBSClient(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	createOpts := OBSCreateBucketOpts{
+		ACL:          d.Get("acl").(string),
+		StorageClass: d.Get("storage_class").(string),
+		Location:     region,
+		MultiAZ:      d.Get("multi_az").(bool),
+	}
+
+	log.Printf("[DEBUG] OBS bucket create: %s, options: %#v", bucket, createOpts)
+	if err := obsClient.CreateBucket(bucket, createOpts); err != nil {
+		return fmt.Errorf("Error creating OBS bucket %s: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	if quota := d.Get("quota").(int); quota != 0 {
+		if err := obsClient.SetBucketQuota(bucket, int64(quota)); err != nil {
+			return fmt.Errorf("Error setting quota of OBS bucket %s: %s", bucket, err)
+		}
+	}
+
+	if policy := d.Get("policy").(string); policy != "" {
+		if err := obsClient.SetBucketPolicy(bucket, policy); err != nil {
+			return fmt.Errorf("Error setting policy of OBS bucket %s: %s", bucket, err)
+		}
+	}
+
+	return resourceObsBucketRead(d, meta)
+}
+
+func resourceObsBucketRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.computeOBSClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud OBS client: %s", err)
+	}
+
+	bucket, err := obsClient.HeadBucket(d.Id())
+	if err != nil {
+		if isOBSNotFound(err) {
+			log.Printf("[WARN] OBS bucket (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading OBS bucket %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved OBS bucket %s: %+v", d.Id(), bucket)
+
+	d.Set("bucket", d.Id())
+	d.Set("storage_class", bucket.StorageClass)
+	d.Set("multi_az", bucket.MultiAZ)
+	d.Set("bucket_domain_name", obsClient.BucketDomainName(d.Id()))
+	if bucket.Location != "" {
+		d.Set("region", bucket.Location)
+	} else {
+		d.Set("region", GetRegion(d, config))
+	}
+
+	acl, err := obsClient.GetBucketACL(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading ACL of OBS bucket %s: %s", d.Id(), err)
+	}
+	d.Set("acl", acl)
+
+	quota, err := obsClient.GetBucketQuota(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading quota of OBS bucket %s: %s", d.Id(), err)
+	}
+	d.Set("quota", quota)
+
+	policy, err := obsClient.GetBucketPolicy(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading policy of OBS bucket %s: %s", d.Id(), err)
+	}
+	policy, err = normalizeJsonString(policy)
+	if err != nil {
+		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	}
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceObsBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.computeOBSClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud OBS client: %s", err)
+	}
+
+	bucket := d.Id()
+
+	if d.HasChange("acl") {
+		acl := d.Get("acl").(string)
+		log.Printf("[DEBUG] OBS bucket: %s, put ACL: %s", bucket, acl)
+		if err := obsClient.SetBucketACL(bucket, acl); err != nil {
+			return fmt.Errorf("Error setting ACL of OBS bucket %s: %s", bucket, err)
+		}
+	}
+
+	if d.HasChange("storage_class") {
+		storageClass := d.Get("storage_class").(string)
+		log.Printf("[DEBUG] OBS bucket: %s, put storage class: %s", bucket, storageClass)
+		if err := obsClient.SetBucketStorageClass(bucket, storageClass); err != nil {
+			return fmt.Errorf("Error setting storage class of OBS bucket %s: %s", bucket, err)
+		}
+	}
+
+	if d.HasChange("quota") {
+		quota := d.Get("quota").(int)
+		log.Printf("[DEBUG] OBS bucket: %s, put quota: %d", bucket, quota)
+		if err := obsClient.SetBucketQuota(bucket, int64(quota)); err != nil {
+			return fmt.Errorf("Error setting quota of OBS bucket %s: %s", bucket, err)
+		}
+	}
+
+	if d.HasChange("policy") {
+		policy := d.Get("policy").(string)
+		if policy == "" {
+			log.Printf("[DEBUG] OBS bucket: %s, delete policy", bucket)
+			err = obsClient.DeleteBucketPolicy(bucket)
+		} else {
+			log.Printf("[DEBUG] OBS bucket: %s, put policy: %s", bucket, policy)
+			err = obsClient.SetBucketPolicy(bucket, policy)
+		}
+		if err != nil {
+			return fmt.Errorf("Error setting policy of OBS bucket %s: %s", bucket, err)
+		}
+	}
+
+	return resourceObsBucketRead(d, meta)
+}
+
+func resourceObsBucketDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.computeOBSClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud OBS client: %s", err)
+	}
+
+	log.Printf("[DEBUG] OBS Delete Bucket: %s", d.Id())
+	if err := obsClient.DeleteBucket(d.Id()); err != nil && !isOBSNotFound(err) {
+		return fmt.Errorf("Error deleting OBS bucket %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,383 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccObsBucket_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "huaweicloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy(testAccProvider),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccObsBucket_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(testAccProvider, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "WARM"),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "true"),
+					resource.TestCheckResourceAttr(resourceName, "quota", "1073741824"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccObsBucket_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(testAccProvider, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "COLD"),
+					resource.TestCheckResourceAttr(resourceName, "quota", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy", ""),
+				),
+			},
+		},
+	})
+}
+
+// TestObsBucket_standIn runs the whole life cycle of the resource against a
+// local stand-in of OBS, which checks the signature of every request.
+func TestObsBucket_standIn(t *testing.T) {
+	obs := newTestOBSServer(t, "access-key", "secret-key")
+	defer obs.Close()
+
+	provider := Provider().(*schema.Provider)
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return &Config{
+			AccessKey: "access-key",
+			SecretKey: "secret-key",
+			Region:    "cn-north-1",
+			Endpoints: map[string]string{"obs": obs.URL},
+		}, nil
+	}
+
+	rInt := acctest.RandInt()
+	resourceName := "huaweicloud_obs_bucket.bucket"
+	bucket := fmt.Sprintf("tf-test-bucket-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:   true,
+		Providers:    map[string]terraform.ResourceProvider{"huaweicloud": provider},
+		CheckDestroy: testAccCheckObsBucketDestroy(provider),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccObsBucket_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(provider, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region", "cn-north-1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "WARM"),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "true"),
+					resource.TestCheckResourceAttr(resourceName, "quota", "1073741824"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_domain_name",
+						bucket+"."+strings.TrimPrefix(obs.URL, "http://")),
+					obs.checkBucket(bucket, func(b *testOBSBucket) error {
+						if b.ACL != "public-read" {
+							return fmt.Errorf("Unexpected ACL: %s", b.ACL)
+						}
+						if !strings.Contains(b.Policy, `"PublicRead"`) {
+							return fmt.Errorf("Unexpected policy: %s", b.Policy)
+						}
+						return nil
+					}),
+				),
+			},
+			resource.TestStep{
+				Config: testAccObsBucket_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "COLD"),
+					resource.TestCheckResourceAttr(resourceName, "quota", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy", ""),
+					obs.checkBucket(bucket, func(b *testOBSBucket) error {
+						if b.ACL != "private" {
+							return fmt.Errorf("Unexpected ACL: %s", b.ACL)
+						}
+						if b.Policy != "" {
+							return fmt.Errorf("Expected the policy to be deleted, got %s", b.Policy)
+						}
+						return nil
+					}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketDestroy(provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := provider.Meta().(*Config)
+		obsClient, err := config.computeOBSClient(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud OBS client: %s", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "huaweicloud_obs_bucket" {
+				continue
+			}
+
+			_, err := obsClient.HeadBucket(rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("OBS bucket %s still exists", rs.Primary.ID)
+			}
+			if !isOBSNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObsBucketExists(provider *schema.Provider, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := provider.Meta().(*Config)
+		obsClient, err := config.computeOBSClient(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud OBS client: %s", err)
+		}
+
+		_, err = obsClient.HeadBucket(rs.Primary.ID)
+		return err
+	}
+}
+
+type testOBSBucket struct {
+	Location     string
+	StorageClass string
+	MultiAZ      bool
+	ACL          string
+	Quota        int64
+	Policy       string
+}
+
+// testOBSServer is an in-memory stand-in of the OBS bucket API.
+type testOBSServer struct {
+	*httptest.Server
+
+	t         *testing.T
+	accessKey string
+	secretKey string
+
+	mu      sync.Mutex
+	buckets map[string]*testOBSBucket
+}
+
+func newTestOBSServer(t *testing.T, accessKey, secretKey string) *testOBSServer {
+	s := &testOBSServer{
+		t:         t,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		buckets:   make(map[string]*testOBSBucket),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+func (s *testOBSServer) checkBucket(name string, check func(*testOBSBucket) error) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		b, ok := s.buckets[name]
+		if !ok {
+			return fmt.Errorf("Bucket %s not found in the OBS stand-in", name)
+		}
+		return check(b)
+	}
+}
+
+func (s *testOBSServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		s.t.Error(err)
+		return
+	}
+
+	if err := testOBSVerifyRequest(r, s.accessKey, s.secretKey); err != nil {
+		s.t.Error(err)
+		s.error(w, http.StatusForbidden, "SignatureDoesNotMatch")
+		return
+	}
+	if len(body) > 0 {
+		sum := md5.Sum(body)
+		if r.Header.Get("Content-MD5") != base64.StdEncoding.EncodeToString(sum[:]) {
+			s.error(w, http.StatusBadRequest, "InvalidDigest")
+			return
+		}
+	}
+
+	name := strings.Trim(r.URL.Path, "/")
+	b, exists := s.buckets[name]
+	if !exists && !(r.Method == "PUT" && r.URL.RawQuery == "") {
+		s.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch r.Method + " " + r.URL.RawQuery {
+	case "PUT ":
+		if exists {
+			s.error(w, http.StatusConflict, "BucketAlreadyExists")
+			return
+		}
+		var conf obsCreateBucketConfiguration
+		if len(body) > 0 {
+			if err := xml.Unmarshal(body, &conf); err != nil {
+				s.error(w, http.StatusBadRequest, "MalformedXML")
+				return
+			}
+		}
+		b = &testOBSBucket{
+			Location:     conf.Location,
+			StorageClass: r.Header.Get(obsHeaderStorageClass),
+			MultiAZ:      r.Header.Get(obsHeaderAZRedundancy) == obsMultiAZ,
+			ACL:          r.Header.Get(obsHeaderACL),
+		}
+		if b.StorageClass == "" {
+			b.StorageClass = "STANDARD"
+		}
+		if b.ACL == "" {
+			b.ACL = "private"
+		}
+		s.buckets[name] = b
+	case "HEAD ":
+		w.Header().Set(obsHeaderLocation, b.Location)
+		w.Header().Set(obsHeaderStorageClass, b.StorageClass)
+		if b.MultiAZ {
+			w.Header().Set(obsHeaderAZRedundancy, obsMultiAZ)
+		}
+	case "DELETE ":
+		delete(s.buckets, name)
+		w.WriteHeader(http.StatusNoContent)
+	case "GET acl":
+		policy := obsAccessControlPolicy{
+			Grants: []obsGrant{{GranteeID: "owner", Permission: "FULL_CONTROL"}},
+		}
+		delivered := strings.HasSuffix(b.ACL, "-delivered")
+		if strings.HasPrefix(b.ACL, "public-read") {
+			policy.Grants = append(policy.Grants, obsGrant{GranteeCanned: "Everyone", Permission: "READ", Delivered: delivered})
+		}
+		if strings.HasPrefix(b.ACL, "public-read-write") {
+			policy.Grants = append(policy.Grants, obsGrant{GranteeCanned: "Everyone", Permission: "WRITE", Delivered: delivered})
+		}
+		s.writeXML(w, policy)
+	case "PUT acl":
+		b.ACL = r.Header.Get(obsHeaderACL)
+	case "PUT storageClass":
+		var storageClass obsStorageClass
+		if err := xml.Unmarshal(body, &storageClass); err != nil {
+			s.error(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+		b.StorageClass = storageClass.StorageClass
+	case "GET quota":
+		s.writeXML(w, obsQuota{StorageQuota: b.Quota})
+	case "PUT quota":
+		var quota obsQuota
+		if err := xml.Unmarshal(body, &quota); err != nil {
+			s.error(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+		b.Quota = quota.StorageQuota
+	case "GET policy":
+		if b.Policy == "" {
+			s.error(w, http.StatusNotFound, "NoSuchBucketPolicy")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(b.Policy))
+	case "PUT policy":
+		b.Policy = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	case "DELETE policy":
+		b.Policy = ""
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.t.Errorf("Unexpected OBS request: %s %s", r.Method, r.URL)
+		s.error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *testOBSServer) writeXML(w http.ResponseWriter, v interface{}) {
+	var buf bytes.Buffer
+	if err := xml.NewEncoder(&buf).Encode(v); err != nil {
+		s.t.Error(err)
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write(buf.Bytes())
+}
+
+func (s *testOBSServer) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message><RequestId>0001</RequestId></Error>",
+		code, http.StatusText(status))
+}
+
+func testAccObsBucket_basic(randInt int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%d"
+  storage_class = "WARM"
+  acl           = "public-read"
+  multi_az      = true
+  quota         = 1073741824
+
+  policy = <<POLICY
+{
+  "Statement": [
+    {
+      "Sid": "PublicRead",
+      "Effect": "Allow",
+      "Principal": {"ID": ["*"]},
+      "Action": ["GetObject"],
+      "Resource": ["tf-test-bucket-%d/*"]
+    }
+  ]
+}
+POLICY
+}
+`, randInt, randInt)
+}
+
+func testAccObsBucket_update(randInt int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%d"
+  storage_class = "COLD"
+  multi_az      = true
+}
+`, randInt)
+}
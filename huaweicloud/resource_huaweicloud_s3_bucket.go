@@ -269,6 +269,116 @@ func resourceS3Bucket() *schema.Resource {
 				},
 			},
 
+			"replication_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agency": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rules": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      rulesHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketReplicationRuleId,
+									},
+									"destination": {
+										Type:     schema.TypeSet,
+										MaxItems: 1,
+										MinItems: 1,
+										Required: true,
+										Set:      destinationHash,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"storage_class": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+														return ValidateStringList(v, k, []string{
+															s3.StorageClassStandard,
+															s3.StorageClassStandardIa,
+															s3.ObjectStorageClassGlacier,
+														})
+													},
+												},
+											},
+										},
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketReplicationRulePrefix,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+											return ValidateStringList(v, k, []string{
+												s3.ReplicationRuleStatusEnabled,
+												s3.ReplicationRuleStatusDisabled,
+											})
+										},
+									},
+									"priority": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"apply_server_side_encryption_by_default": {
+										Type:     schema.TypeList,
+										MaxItems: 1,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kms_master_key_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"sse_algorithm": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateS3BucketObjectServerSideEncryption,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -299,6 +409,10 @@ func resourceS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 	d.Set("bucket", bucket)
 	acl := d.Get("acl").(string)
 
+	if err := validateS3BucketReplicationConfiguration(d); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] S3 bucket create: %s, ACL: %s", bucket, acl)
 
 	req := &s3.CreateBucketInput{
@@ -353,6 +467,10 @@ func resourceS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
 	}
 
+	if err := validateS3BucketReplicationConfiguration(d); err != nil {
+		return err
+	}
+
 	/*
 		if err := setTagsS3(s3conn, d); err != nil {
 			return fmt.Errorf("%q: %s", d.Get("bucket").(string), err)
@@ -376,11 +494,27 @@ func resourceS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Replication must be removed before versioning is suspended, and
+	// versioning must be enabled before replication is configured.
+	replicationRemoved := d.HasChange("replication_configuration") &&
+		len(d.Get("replication_configuration").([]interface{})) == 0
+	if replicationRemoved {
+		if err := resourceS3BucketReplicationConfigurationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("versioning") {
 		if err := resourceS3BucketVersioningUpdate(s3conn, d); err != nil {
 			return err
 		}
 	}
+
+	if d.HasChange("replication_configuration") && !replicationRemoved {
+		if err := resourceS3BucketReplicationConfigurationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
 	if d.HasChange("acl") {
 		if err := resourceS3BucketAclUpdate(s3conn, d); err != nil {
 			return err
@@ -399,6 +533,12 @@ func resourceS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("server_side_encryption_configuration") {
+		if err := resourceS3BucketServerSideEncryptionConfigurationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceS3BucketRead(d, meta)
 }
 
@@ -733,6 +873,46 @@ func resourceS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Read the bucket replication configuration
+	replicationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+	replication := replicationResponse.(*s3.GetBucketReplicationOutput)
+	if err != nil {
+		// An S3 Bucket might not have a replication configuration set.
+		if awsError, ok := err.(awserr.RequestFailure); !ok || awsError.StatusCode() != 404 {
+			return fmt.Errorf("error getting S3 bucket replication: %s", err)
+		}
+		log.Printf("[WARN] S3 bucket: %s, no replication configuration could be found.", d.Id())
+	}
+	log.Printf("[DEBUG] S3 Bucket: %s, replication: %v", d.Id(), replication)
+	if err := d.Set("replication_configuration",
+		flattenS3ReplicationConfiguration(replication.ReplicationConfiguration)); err != nil {
+		return fmt.Errorf("error setting replication_configuration: %s", err)
+	}
+
+	// Read the bucket server side encryption configuration
+	encryptionResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+	encryption := encryptionResponse.(*s3.GetBucketEncryptionOutput)
+	if err != nil {
+		// An S3 Bucket might not have a default encryption configuration set.
+		if awsError, ok := err.(awserr.RequestFailure); !ok || awsError.StatusCode() != 404 {
+			return fmt.Errorf("error getting S3 bucket encryption: %s", err)
+		}
+		log.Printf("[WARN] S3 bucket: %s, no server side encryption configuration could be found.", d.Id())
+	}
+	log.Printf("[DEBUG] S3 Bucket: %s, server side encryption: %v", d.Id(), encryption)
+	if err := d.Set("server_side_encryption_configuration",
+		flattenS3ServerSideEncryptionConfiguration(encryption.ServerSideEncryptionConfiguration)); err != nil {
+		return fmt.Errorf("error setting server_side_encryption_configuration: %s", err)
+	}
+
 	// Add the region as an attribute
 
 	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
@@ -1222,6 +1402,243 @@ func resourceS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
+// validateS3BucketReplicationConfiguration returns an error when replication
+// is configured on a bucket without versioning, which S3 rejects.
+func validateS3BucketReplicationConfiguration(d *schema.ResourceData) error {
+	if len(d.Get("replication_configuration").([]interface{})) == 0 {
+		return nil
+	}
+
+	v := d.Get("versioning").([]interface{})
+	if len(v) == 0 || !v[0].(map[string]interface{})["enabled"].(bool) {
+		return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
+	}
+
+	return nil
+}
+
+func resourceS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	replicationConfiguration := d.Get("replication_configuration").([]interface{})
+
+	if len(replicationConfiguration) == 0 {
+		log.Printf("[DEBUG] Delete S3 bucket replication configuration: %s", bucket)
+		i := &s3.DeleteBucketReplicationInput{
+			Bucket: aws.String(bucket),
+		}
+
+		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.DeleteBucketReplication(i)
+		})
+		if err != nil {
+			return fmt.Errorf("error removing S3 bucket replication: %s", err)
+		}
+		return nil
+	}
+
+	i := &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String(bucket),
+		ReplicationConfiguration: expandS3ReplicationConfiguration(replicationConfiguration),
+	}
+	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
+
+	// Versioning may take a while to be seen as enabled right after it has
+	// been turned on.
+	_, err := retryOnAwsCodes([]string{"NoSuchBucket", "InvalidRequest"}, func() (interface{}, error) {
+		return s3conn.PutBucketReplication(i)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 replication configuration: %s", err)
+	}
+
+	return nil
+}
+
+// expandS3ReplicationConfiguration builds the replication configuration.
+// Rules are filtered by prefix and prioritized as soon as one of them has a
+// priority, S3 does not accept a mix of both kinds of rules.
+func expandS3ReplicationConfiguration(l []interface{}) *s3.ReplicationConfiguration {
+	c := l[0].(map[string]interface{})
+	rawRules := c["rules"].(*schema.Set).List()
+
+	withPriority := false
+	for _, v := range rawRules {
+		if v.(map[string]interface{})["priority"].(int) != 0 {
+			withPriority = true
+		}
+	}
+
+	rules := make([]*s3.ReplicationRule, 0, len(rawRules))
+	for _, v := range rawRules {
+		rr := v.(map[string]interface{})
+
+		rule := &s3.ReplicationRule{
+			Status: aws.String(rr["status"].(string)),
+		}
+		if id := rr["id"].(string); id != "" {
+			rule.ID = aws.String(id)
+		}
+
+		destination := &s3.Destination{}
+		for _, d := range rr["destination"].(*schema.Set).List() {
+			bd := d.(map[string]interface{})
+			destination.Bucket = aws.String(bd["bucket"].(string))
+			if storageClass := bd["storage_class"].(string); storageClass != "" {
+				destination.StorageClass = aws.String(storageClass)
+			}
+		}
+		rule.Destination = destination
+
+		if withPriority {
+			rule.Filter = &s3.ReplicationRuleFilter{
+				Prefix: aws.String(rr["prefix"].(string)),
+			}
+			rule.Priority = aws.Int64(int64(rr["priority"].(int)))
+			rule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
+				Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
+			}
+		} else {
+			rule.Prefix = aws.String(rr["prefix"].(string))
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return &s3.ReplicationConfiguration{
+		Role:  aws.String(c["agency"].(string)),
+		Rules: rules,
+	}
+}
+
+func flattenS3ReplicationConfiguration(c *s3.ReplicationConfiguration) []map[string]interface{} {
+	replicationConfiguration := make([]map[string]interface{}, 0, 1)
+	if c == nil {
+		return replicationConfiguration
+	}
+
+	rules := make([]interface{}, 0, len(c.Rules))
+	for _, v := range c.Rules {
+		rule := map[string]interface{}{
+			"id":       aws.StringValue(v.ID),
+			"status":   aws.StringValue(v.Status),
+			"prefix":   aws.StringValue(v.Prefix),
+			"priority": int(aws.Int64Value(v.Priority)),
+		}
+		if v.Filter != nil {
+			rule["prefix"] = aws.StringValue(v.Filter.Prefix)
+		}
+
+		if v.Destination != nil {
+			destination := map[string]interface{}{
+				"bucket":        aws.StringValue(v.Destination.Bucket),
+				"storage_class": aws.StringValue(v.Destination.StorageClass),
+			}
+			rule["destination"] = schema.NewSet(destinationHash, []interface{}{destination})
+		}
+
+		rules = append(rules, rule)
+	}
+
+	replicationConfiguration = append(replicationConfiguration, map[string]interface{}{
+		"agency": aws.StringValue(c.Role),
+		"rules":  schema.NewSet(rulesHash, rules),
+	})
+	return replicationConfiguration
+}
+
+func resourceS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
+
+	if len(serverSideEncryptionConfiguration) == 0 {
+		log.Printf("[DEBUG] Delete server side encryption configuration: %#v", serverSideEncryptionConfiguration)
+		i := &s3.DeleteBucketEncryptionInput{
+			Bucket: aws.String(bucket),
+		}
+
+		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.DeleteBucketEncryption(i)
+		})
+		if err != nil {
+			return fmt.Errorf("error removing S3 bucket server side encryption: %s", err)
+		}
+		return nil
+	}
+
+	i := &s3.PutBucketEncryptionInput{
+		Bucket:                            aws.String(bucket),
+		ServerSideEncryptionConfiguration: expandS3ServerSideEncryptionConfiguration(serverSideEncryptionConfiguration),
+	}
+	log.Printf("[DEBUG] S3 put bucket server side encryption configuration: %#v", i)
+
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketEncryption(i)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 server side encryption configuration: %s", err)
+	}
+
+	return nil
+}
+
+func expandS3ServerSideEncryptionConfiguration(l []interface{}) *s3.ServerSideEncryptionConfiguration {
+	c := l[0].(map[string]interface{})
+
+	rules := make([]*s3.ServerSideEncryptionRule, 0)
+	for _, v := range c["rule"].([]interface{}) {
+		rule := v.(map[string]interface{})
+		defaults := rule["apply_server_side_encryption_by_default"].([]interface{})
+		if len(defaults) == 0 {
+			continue
+		}
+		sse := defaults[0].(map[string]interface{})
+
+		byDefault := &s3.ServerSideEncryptionByDefault{
+			SSEAlgorithm: aws.String(sse["sse_algorithm"].(string)),
+		}
+		if v := sse["kms_master_key_id"].(string); v != "" {
+			byDefault.KMSMasterKeyID = aws.String(v)
+		}
+
+		rules = append(rules, &s3.ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: byDefault,
+		})
+	}
+
+	return &s3.ServerSideEncryptionConfiguration{
+		Rules: rules,
+	}
+}
+
+func flattenS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionConfiguration) []map[string]interface{} {
+	encryptionConfiguration := make([]map[string]interface{}, 0, 1)
+	if c == nil || len(c.Rules) == 0 {
+		return encryptionConfiguration
+	}
+
+	rules := make([]interface{}, 0, len(c.Rules))
+	for _, v := range c.Rules {
+		if v.ApplyServerSideEncryptionByDefault == nil {
+			continue
+		}
+		byDefault := map[string]interface{}{
+			"sse_algorithm":     aws.StringValue(v.ApplyServerSideEncryptionByDefault.SSEAlgorithm),
+			"kms_master_key_id": aws.StringValue(v.ApplyServerSideEncryptionByDefault.KMSMasterKeyID),
+		}
+		rules = append(rules, map[string]interface{}{
+			"apply_server_side_encryption_by_default": []interface{}{byDefault},
+		})
+	}
+	if len(rules) == 0 {
+		return encryptionConfiguration
+	}
+
+	encryptionConfiguration = append(encryptionConfiguration, map[string]interface{}{
+		"rule": rules,
+	})
+	return encryptionConfiguration
+}
+
 func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 
@@ -1477,6 +1894,12 @@ func rulesHash(v interface{}) int {
 	if v, ok := m["status"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 	}
+	if v, ok := m["priority"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	if v, ok := m["destination"].(*schema.Set); ok && v.Len() > 0 {
+		buf.WriteString(fmt.Sprintf("%d-", destinationHash(v.List()[0])))
+	}
 	return hashcode.String(buf.String())
 }
 
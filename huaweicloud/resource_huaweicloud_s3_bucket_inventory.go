@@ -0,0 +1,406 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceS3BucketInventory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceS3BucketInventoryCreate,
+		Read:   resourceS3BucketInventoryRead,
+		Update: resourceS3BucketInventoryUpdate,
+		Delete: resourceS3BucketInventoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      s3BucketInventoryConfigurationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"included_object_versions": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{
+									s3.InventoryIncludedObjectVersionsAll,
+									s3.InventoryIncludedObjectVersionsCurrent,
+								})
+							},
+						},
+						"frequency": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{
+									s3.InventoryFrequencyDaily,
+									s3.InventoryFrequencyWeekly,
+								})
+							},
+						},
+						"filter_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"optional_fields": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+									return ValidateStringList(v, k, []string{
+										s3.InventoryOptionalFieldSize,
+										s3.InventoryOptionalFieldLastModifiedDate,
+										s3.InventoryOptionalFieldStorageClass,
+										s3.InventoryOptionalFieldEtag,
+										s3.InventoryOptionalFieldIsMultipartUploaded,
+										s3.InventoryOptionalFieldReplicationStatus,
+									})
+								},
+							},
+							Set: schema.HashString,
+						},
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"format": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  s3.InventoryFormatCsv,
+										ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+											return ValidateStringList(v, k, []string{
+												s3.InventoryFormatCsv,
+											})
+										},
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceS3BucketInventoryCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	if err := resourceS3BucketInventoryApply(d, meta, bucket); err != nil {
+		return err
+	}
+
+	d.SetId(bucket)
+
+	return resourceS3BucketInventoryRead(d, meta)
+}
+
+func resourceS3BucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+	}
+
+	inventoryConfigurations, err := listS3BucketInventoryConfigurations(s3conn, d.Id())
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
+			log.Printf("[WARN] S3 bucket (%s) not found, removing inventory configurations from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading S3 inventory configurations of bucket %s: %s", d.Id(), err)
+	}
+
+	configurations := make([]interface{}, 0, len(inventoryConfigurations))
+	for _, c := range inventoryConfigurations {
+		configurations = append(configurations, flattenS3BucketInventoryConfiguration(c))
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("region", GetRegion(d, config))
+	if err := d.Set("configuration", schema.NewSet(s3BucketInventoryConfigurationHash, configurations)); err != nil {
+		return fmt.Errorf("Error setting configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceS3BucketInventoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceS3BucketInventoryApply(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceS3BucketInventoryRead(d, meta)
+}
+
+func resourceS3BucketInventoryDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+	}
+
+	if err := updateS3BucketInventoryConfigurations(s3conn, d.Id(), nil); err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
+			return nil
+		}
+		return fmt.Errorf("Error deleting S3 inventory configurations: %s", err)
+	}
+
+	return nil
+}
+
+// resourceS3BucketInventoryApply makes the inventory configurations of the
+// bucket match the configured ones.
+func resourceS3BucketInventoryApply(d *schema.ResourceData, meta interface{}, bucket string) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+	}
+
+	var configurations []*s3.InventoryConfiguration
+	for _, raw := range d.Get("configuration").(*schema.Set).List() {
+		configurations = append(configurations, expandS3BucketInventoryConfiguration(raw.(map[string]interface{})))
+	}
+
+	if err := updateS3BucketInventoryConfigurations(s3conn, bucket, configurations); err != nil {
+		return fmt.Errorf("Error putting S3 inventory configurations: %s", err)
+	}
+
+	return nil
+}
+
+// updateS3BucketInventoryConfigurations compares the live inventory
+// configurations of the bucket with the given ones, puts the new and
+// changed configurations and deletes the ones which are not wanted.
+func updateS3BucketInventoryConfigurations(s3conn *s3.S3, bucket string, configurations []*s3.InventoryConfiguration) error {
+	live, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return listS3BucketInventoryConfigurations(s3conn, bucket)
+	})
+	if err != nil {
+		return err
+	}
+
+	puts, deletes := diffS3BucketInventoryConfigurations(live.([]*s3.InventoryConfiguration), configurations)
+	for _, id := range deletes {
+		log.Printf("[INFO] Deleting S3 inventory configuration %s of bucket %s", id, bucket)
+		_, err := s3conn.DeleteBucketInventoryConfiguration(&s3.DeleteBucketInventoryConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(id),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, c := range puts {
+		i := &s3.PutBucketInventoryConfigurationInput{
+			Bucket:                 aws.String(bucket),
+			Id:                     c.Id,
+			InventoryConfiguration: c,
+		}
+		log.Printf("[DEBUG] S3 put bucket inventory configuration: %#v", i)
+		if _, err := s3conn.PutBucketInventoryConfiguration(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listS3BucketInventoryConfigurations(s3conn *s3.S3, bucket string) ([]*s3.InventoryConfiguration, error) {
+	var configurations []*s3.InventoryConfiguration
+
+	i := &s3.ListBucketInventoryConfigurationsInput{
+		Bucket: aws.String(bucket),
+	}
+	for {
+		out, err := s3conn.ListBucketInventoryConfigurations(i)
+		if err != nil {
+			return nil, err
+		}
+		configurations = append(configurations, out.InventoryConfigurationList...)
+
+		if !aws.BoolValue(out.IsTruncated) {
+			break
+		}
+		i.ContinuationToken = out.NextContinuationToken
+	}
+
+	return configurations, nil
+}
+
+// diffS3BucketInventoryConfigurations returns the configurations which have
+// to be put and the ids of the configurations which have to be deleted to
+// turn the live inventory configurations into the desired ones.
+func diffS3BucketInventoryConfigurations(live, desired []*s3.InventoryConfiguration) (puts []*s3.InventoryConfiguration, deletes []string) {
+	liveHashes := make(map[string]int, len(live))
+	for _, c := range live {
+		liveHashes[aws.StringValue(c.Id)] = s3BucketInventoryConfigurationHash(flattenS3BucketInventoryConfiguration(c))
+	}
+	desiredIds := make(map[string]bool, len(desired))
+	for _, c := range desired {
+		id := aws.StringValue(c.Id)
+		desiredIds[id] = true
+
+		hash, ok := liveHashes[id]
+		if !ok || hash != s3BucketInventoryConfigurationHash(flattenS3BucketInventoryConfiguration(c)) {
+			puts = append(puts, c)
+		}
+	}
+	for _, c := range live {
+		if id := aws.StringValue(c.Id); !desiredIds[id] {
+			deletes = append(deletes, id)
+		}
+	}
+
+	return puts, deletes
+}
+
+func expandS3BucketInventoryConfiguration(m map[string]interface{}) *s3.InventoryConfiguration {
+	c := &s3.InventoryConfiguration{
+		Id:                     aws.String(m["name"].(string)),
+		IsEnabled:              aws.Bool(m["enabled"].(bool)),
+		IncludedObjectVersions: aws.String(m["included_object_versions"].(string)),
+		Schedule: &s3.InventorySchedule{
+			Frequency: aws.String(m["frequency"].(string)),
+		},
+	}
+
+	if v := m["filter_prefix"].(string); v != "" {
+		c.Filter = &s3.InventoryFilter{
+			Prefix: aws.String(v),
+		}
+	}
+	if v, ok := m["optional_fields"].(*schema.Set); ok && v.Len() > 0 {
+		c.OptionalFields = expandStringList(v.List())
+	}
+
+	destination := &s3.InventoryS3BucketDestination{}
+	if l := m["destination"].([]interface{}); len(l) > 0 && l[0] != nil {
+		dest := l[0].(map[string]interface{})
+		destination.Bucket = aws.String(dest["bucket"].(string))
+		destination.Format = aws.String(dest["format"].(string))
+		if v := dest["prefix"].(string); v != "" {
+			destination.Prefix = aws.String(v)
+		}
+	}
+	c.Destination = &s3.InventoryDestination{
+		S3BucketDestination: destination,
+	}
+
+	return c
+}
+
+func flattenS3BucketInventoryConfiguration(c *s3.InventoryConfiguration) map[string]interface{} {
+	m := map[string]interface{}{
+		"name":                     aws.StringValue(c.Id),
+		"enabled":                  aws.BoolValue(c.IsEnabled),
+		"included_object_versions": aws.StringValue(c.IncludedObjectVersions),
+		"optional_fields":          schema.NewSet(schema.HashString, flattenStringList(c.OptionalFields)),
+	}
+
+	if c.Schedule != nil {
+		m["frequency"] = aws.StringValue(c.Schedule.Frequency)
+	}
+	if c.Filter != nil {
+		m["filter_prefix"] = aws.StringValue(c.Filter.Prefix)
+	}
+	if c.Destination != nil && c.Destination.S3BucketDestination != nil {
+		dest := c.Destination.S3BucketDestination
+		m["destination"] = []interface{}{
+			map[string]interface{}{
+				"bucket": aws.StringValue(dest.Bucket),
+				"format": aws.StringValue(dest.Format),
+				"prefix": aws.StringValue(dest.Prefix),
+			},
+		}
+	}
+
+	return m
+}
+
+func s3BucketInventoryConfigurationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["name"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["enabled"]; ok {
+		buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
+	}
+	if v, ok := m["included_object_versions"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["frequency"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["filter_prefix"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["optional_fields"].(*schema.Set); ok {
+		fields := make([]string, 0, v.Len())
+		for _, f := range v.List() {
+			fields = append(fields, f.(string))
+		}
+		sort.Strings(fields)
+		for _, f := range fields {
+			buf.WriteString(fmt.Sprintf("%s-", f))
+		}
+	}
+	if l, ok := m["destination"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		dest := l[0].(map[string]interface{})
+		for _, k := range []string{"bucket", "format", "prefix"} {
+			if v, ok := dest[k]; ok {
+				buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+			}
+		}
+	}
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,307 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testS3BucketInventoryConfiguration(id, frequency string) *s3.InventoryConfiguration {
+	return &s3.InventoryConfiguration{
+		Id:                     aws.String(id),
+		IsEnabled:              aws.Bool(true),
+		IncludedObjectVersions: aws.String(s3.InventoryIncludedObjectVersionsAll),
+		Schedule: &s3.InventorySchedule{
+			Frequency: aws.String(frequency),
+		},
+		OptionalFields: []*string{
+			aws.String(s3.InventoryOptionalFieldSize),
+			aws.String(s3.InventoryOptionalFieldEtag),
+		},
+		Destination: &s3.InventoryDestination{
+			S3BucketDestination: &s3.InventoryS3BucketDestination{
+				Bucket: aws.String("inventory-bucket"),
+				Format: aws.String(s3.InventoryFormatCsv),
+			},
+		},
+	}
+}
+
+func TestDiffS3BucketInventoryConfigurations(t *testing.T) {
+	c1 := testS3BucketInventoryConfiguration("c1", s3.InventoryFrequencyDaily)
+	c2 := testS3BucketInventoryConfiguration("c2", s3.InventoryFrequencyDaily)
+	c2Weekly := testS3BucketInventoryConfiguration("c2", s3.InventoryFrequencyWeekly)
+	c3 := testS3BucketInventoryConfiguration("c3", s3.InventoryFrequencyWeekly)
+
+	// The same configuration with the optional fields in another order.
+	c1Reordered := testS3BucketInventoryConfiguration("c1", s3.InventoryFrequencyDaily)
+	c1Reordered.OptionalFields = []*string{c1.OptionalFields[1], c1.OptionalFields[0]}
+
+	cases := []struct {
+		live, desired []*s3.InventoryConfiguration
+		puts          []*s3.InventoryConfiguration
+		deletes       []string
+	}{
+		{
+			live:    []*s3.InventoryConfiguration{c1, c2},
+			desired: []*s3.InventoryConfiguration{c2, c1Reordered},
+		},
+		{
+			live:    nil,
+			desired: []*s3.InventoryConfiguration{c1, c2},
+			puts:    []*s3.InventoryConfiguration{c1, c2},
+		},
+		{
+			live:    []*s3.InventoryConfiguration{c1, c2},
+			desired: nil,
+			deletes: []string{"c1", "c2"},
+		},
+		{
+			live:    []*s3.InventoryConfiguration{c1, c2},
+			desired: []*s3.InventoryConfiguration{c2Weekly, c3},
+			puts:    []*s3.InventoryConfiguration{c2Weekly, c3},
+			deletes: []string{"c1"},
+		},
+	}
+
+	for i, tc := range cases {
+		puts, deletes := diffS3BucketInventoryConfigurations(tc.live, tc.desired)
+		if !reflect.DeepEqual(puts, tc.puts) {
+			t.Errorf("case %d: expected puts %v, got %v", i, tc.puts, puts)
+		}
+		if !reflect.DeepEqual(deletes, tc.deletes) {
+			t.Errorf("case %d: expected deletes %v, got %v", i, tc.deletes, deletes)
+		}
+	}
+}
+
+func TestS3BucketInventoryConfiguration(t *testing.T) {
+	c := testS3BucketInventoryConfiguration("c1", s3.InventoryFrequencyDaily)
+	c.Filter = &s3.InventoryFilter{
+		Prefix: aws.String("documents/"),
+	}
+	c.Destination.S3BucketDestination.Prefix = aws.String("inventory/")
+
+	expanded := expandS3BucketInventoryConfiguration(flattenS3BucketInventoryConfiguration(c))
+	if aws.StringValue(expanded.Id) != "c1" {
+		t.Errorf("expected id c1, got %s", aws.StringValue(expanded.Id))
+	}
+	if !reflect.DeepEqual(expanded.Filter, c.Filter) {
+		t.Errorf("expected filter %s, got %s", c.Filter, expanded.Filter)
+	}
+	if !reflect.DeepEqual(expanded.Destination, c.Destination) {
+		t.Errorf("expected destination %s, got %s", c.Destination, expanded.Destination)
+	}
+	if !reflect.DeepEqual(expanded.Schedule, c.Schedule) {
+		t.Errorf("expected schedule %s, got %s", c.Schedule, expanded.Schedule)
+	}
+	if len(expanded.OptionalFields) != 2 {
+		t.Errorf("expected 2 optional fields, got %d", len(expanded.OptionalFields))
+	}
+}
+
+func TestAccS3BucketInventory_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketInventoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketInventoryConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketInventoryConfigurations("huaweicloud_s3_bucket_inventory.inventory", 1),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket_inventory.inventory", "configuration.#", "1"),
+				),
+			},
+			{
+				Config: testAccS3BucketInventoryConfig_updated(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketInventoryConfigurations("huaweicloud_s3_bucket_inventory.inventory", 2),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket_inventory.inventory", "configuration.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketInventory_drift(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketInventoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketInventoryConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketInventoryAddConfiguration("huaweicloud_s3_bucket_inventory.inventory",
+						fmt.Sprintf("tf-test-bucket-inventory-%d", rInt)),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccS3BucketInventoryConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketInventoryConfigurations("huaweicloud_s3_bucket_inventory.inventory", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckS3BucketInventoryDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	conn, err := config.computeS3conn(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_s3_bucket_inventory" {
+			continue
+		}
+
+		configurations, err := listS3BucketInventoryConfigurations(conn, rs.Primary.ID)
+		if err == nil && len(configurations) != 0 {
+			return fmt.Errorf("S3 bucket %s still has %d inventory configurations", rs.Primary.ID, len(configurations))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckS3BucketInventoryConfigurations(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+		}
+
+		configurations, err := listS3BucketInventoryConfigurations(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(configurations) != count {
+			return fmt.Errorf("Expected %d inventory configurations, got %d", count, len(configurations))
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckS3BucketInventoryAddConfiguration adds an inventory
+// configuration out-of-band to cause drift.
+func testAccCheckS3BucketInventoryAddConfiguration(n, destinationBucket string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+		}
+
+		c := testS3BucketInventoryConfiguration("out-of-band", s3.InventoryFrequencyWeekly)
+		c.Destination.S3BucketDestination.Bucket = aws.String(destinationBucket)
+		_, err = conn.PutBucketInventoryConfiguration(&s3.PutBucketInventoryConfigurationInput{
+			Bucket:                 aws.String(rs.Primary.ID),
+			Id:                     c.Id,
+			InventoryConfiguration: c,
+		})
+
+		return err
+	}
+}
+
+func testAccS3BucketInventoryConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+}
+
+resource "huaweicloud_s3_bucket" "inventory" {
+  bucket = "tf-test-bucket-inventory-%d"
+}
+
+resource "huaweicloud_s3_bucket_inventory" "inventory" {
+  bucket = "${huaweicloud_s3_bucket.bucket.bucket}"
+
+  configuration {
+    name                     = "documents"
+    included_object_versions = "All"
+    frequency                = "Daily"
+    filter_prefix            = "documents/"
+    optional_fields          = ["Size", "LastModifiedDate"]
+
+    destination {
+      bucket = "${huaweicloud_s3_bucket.inventory.bucket}"
+      prefix = "inventory/"
+    }
+  }
+}
+`, randInt, randInt)
+}
+
+func testAccS3BucketInventoryConfig_updated(randInt int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+}
+
+resource "huaweicloud_s3_bucket" "inventory" {
+  bucket = "tf-test-bucket-inventory-%d"
+}
+
+resource "huaweicloud_s3_bucket_inventory" "inventory" {
+  bucket = "${huaweicloud_s3_bucket.bucket.bucket}"
+
+  configuration {
+    name                     = "documents"
+    included_object_versions = "Current"
+    frequency                = "Weekly"
+    filter_prefix            = "documents/"
+    optional_fields          = ["Size", "LastModifiedDate", "ETag"]
+
+    destination {
+      bucket = "${huaweicloud_s3_bucket.inventory.bucket}"
+      prefix = "inventory/"
+    }
+  }
+
+  configuration {
+    name                     = "all"
+    enabled                  = false
+    included_object_versions = "All"
+    frequency                = "Daily"
+
+    destination {
+      bucket = "${huaweicloud_s3_bucket.inventory.bucket}"
+    }
+  }
+}
+`, randInt, randInt)
+}
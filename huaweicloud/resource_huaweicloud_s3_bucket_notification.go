@@ -0,0 +1,235 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceS3BucketNotification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceS3BucketNotificationPut,
+		Read:   resourceS3BucketNotificationRead,
+		Update: resourceS3BucketNotificationPut,
+		Delete: resourceS3BucketNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"topic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"topic_urn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+									return ValidateStringList(v, k, []string{
+										s3.EventS3ObjectCreated,
+										s3.EventS3ObjectCreatedPut,
+										s3.EventS3ObjectCreatedPost,
+										s3.EventS3ObjectCreatedCopy,
+										s3.EventS3ObjectCreatedCompleteMultipartUpload,
+										s3.EventS3ObjectRemoved,
+										s3.EventS3ObjectRemovedDelete,
+										s3.EventS3ObjectRemovedDeleteMarkerCreated,
+									})
+								},
+							},
+							Set: schema.HashString,
+						},
+						"filter_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"filter_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceS3BucketNotificationPut(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	notificationConfiguration := &s3.NotificationConfiguration{
+		TopicConfigurations: expandS3TopicConfigurations(d.Get("topic").([]interface{})),
+	}
+
+	i := &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: notificationConfiguration,
+	}
+	log.Printf("[DEBUG] S3 put bucket notification configuration: %#v", i)
+
+	_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketNotificationConfiguration(i)
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 notification configuration: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceS3BucketNotificationRead(d, meta)
+}
+
+func resourceS3BucketNotificationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+	}
+
+	notificationConfiguration, err := s3conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
+			log.Printf("[WARN] S3 bucket (%s) not found, removing notification configuration from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading S3 notification configuration of bucket %s: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] S3 bucket: %s, read notification configuration: %v", d.Id(), notificationConfiguration)
+
+	d.Set("bucket", d.Id())
+	d.Set("region", GetRegion(d, config))
+	if err := d.Set("topic", flattenS3TopicConfigurations(notificationConfiguration.TopicConfigurations)); err != nil {
+		return fmt.Errorf("Error setting topic: %s", err)
+	}
+
+	return nil
+}
+
+func resourceS3BucketNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+	}
+
+	i := &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(d.Id()),
+		NotificationConfiguration: &s3.NotificationConfiguration{},
+	}
+	log.Printf("[DEBUG] S3 bucket: %s, delete notification configuration", d.Id())
+
+	_, err = s3conn.PutBucketNotificationConfiguration(i)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
+			return nil
+		}
+		return fmt.Errorf("Error deleting S3 notification configuration: %s", err)
+	}
+
+	return nil
+}
+
+func expandS3TopicConfigurations(l []interface{}) []*s3.TopicConfiguration {
+	topicConfigurations := make([]*s3.TopicConfiguration, 0, len(l))
+	for _, v := range l {
+		c := v.(map[string]interface{})
+
+		topicConfiguration := &s3.TopicConfiguration{
+			TopicArn: aws.String(c["topic_urn"].(string)),
+			Events:   expandStringList(c["events"].(*schema.Set).List()),
+		}
+		if id := c["id"].(string); id != "" {
+			topicConfiguration.Id = aws.String(id)
+		}
+
+		filterRules := make([]*s3.FilterRule, 0, 2)
+		if prefix := c["filter_prefix"].(string); prefix != "" {
+			filterRules = append(filterRules, &s3.FilterRule{
+				Name:  aws.String(s3.FilterRuleNamePrefix),
+				Value: aws.String(prefix),
+			})
+		}
+		if suffix := c["filter_suffix"].(string); suffix != "" {
+			filterRules = append(filterRules, &s3.FilterRule{
+				Name:  aws.String(s3.FilterRuleNameSuffix),
+				Value: aws.String(suffix),
+			})
+		}
+		if len(filterRules) > 0 {
+			topicConfiguration.Filter = &s3.NotificationConfigurationFilter{
+				Key: &s3.KeyFilter{
+					FilterRules: filterRules,
+				},
+			}
+		}
+
+		topicConfigurations = append(topicConfigurations, topicConfiguration)
+	}
+
+	return topicConfigurations
+}
+
+func flattenS3TopicConfigurations(topicConfigurations []*s3.TopicConfiguration) []map[string]interface{} {
+	topics := make([]map[string]interface{}, 0, len(topicConfigurations))
+	for _, v := range topicConfigurations {
+		topic := map[string]interface{}{
+			"id":        aws.StringValue(v.Id),
+			"topic_urn": aws.StringValue(v.TopicArn),
+			"events":    schema.NewSet(schema.HashString, flattenStringList(v.Events)),
+		}
+
+		if v.Filter != nil && v.Filter.Key != nil {
+			for _, rule := range v.Filter.Key.FilterRules {
+				// The filter rule names are case insensitive.
+				switch strings.ToLower(aws.StringValue(rule.Name)) {
+				case s3.FilterRuleNamePrefix:
+					topic["filter_prefix"] = aws.StringValue(rule.Value)
+				case s3.FilterRuleNameSuffix:
+					topic["filter_suffix"] = aws.StringValue(rule.Value)
+				}
+			}
+		}
+
+		topics = append(topics, topic)
+	}
+
+	return topics
+}
@@ -0,0 +1,211 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestS3TopicConfigurations(t *testing.T) {
+	topics := []interface{}{
+		map[string]interface{}{
+			"id":            "notification-1",
+			"topic_urn":     "urn:smn:region:project:topic_1",
+			"events":        schema.NewSet(schema.HashString, []interface{}{s3.EventS3ObjectCreated}),
+			"filter_prefix": "images/",
+			"filter_suffix": ".jpg",
+		},
+		map[string]interface{}{
+			"id":            "",
+			"topic_urn":     "urn:smn:region:project:topic_2",
+			"events":        schema.NewSet(schema.HashString, []interface{}{s3.EventS3ObjectRemoved}),
+			"filter_prefix": "",
+			"filter_suffix": "",
+		},
+	}
+
+	c := expandS3TopicConfigurations(topics)
+	if len(c) != 2 {
+		t.Fatalf("expected 2 topic configurations, got %d", len(c))
+	}
+	if aws.StringValue(c[0].Id) != "notification-1" {
+		t.Errorf("expected id notification-1, got %s", aws.StringValue(c[0].Id))
+	}
+	expectedRules := []*s3.FilterRule{
+		{Name: aws.String(s3.FilterRuleNamePrefix), Value: aws.String("images/")},
+		{Name: aws.String(s3.FilterRuleNameSuffix), Value: aws.String(".jpg")},
+	}
+	if c[0].Filter == nil || !reflect.DeepEqual(c[0].Filter.Key.FilterRules, expectedRules) {
+		t.Errorf("unexpected filter: %s", c[0].Filter)
+	}
+	if c[1].Id != nil || c[1].Filter != nil {
+		t.Errorf("expected neither id nor filter, got %s", c[1])
+	}
+
+	// The service may return the filter rule names capitalised.
+	c[0].Filter.Key.FilterRules[0].Name = aws.String("Prefix")
+	flattened := flattenS3TopicConfigurations(c)
+	for i, topic := range flattened {
+		expected := topics[i].(map[string]interface{})
+		for _, k := range []string{"topic_urn", "filter_prefix", "filter_suffix"} {
+			if topic[k] == nil && expected[k] == "" {
+				continue
+			}
+			if topic[k] != expected[k] {
+				t.Errorf("topic %d: expected %s %q, got %q", i, k, expected[k], topic[k])
+			}
+		}
+		if !topic["events"].(*schema.Set).Equal(expected["events"]) {
+			t.Errorf("topic %d: expected events %v, got %v", i, expected["events"], topic["events"])
+		}
+	}
+}
+
+func TestAccS3BucketNotification_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketNotificationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketNotificationTopics("huaweicloud_s3_bucket_notification.notification", 1),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket_notification.notification", "topic.0.filter_prefix", "images/"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket_notification.notification", "topic.0.filter_suffix", ".jpg"),
+				),
+			},
+			{
+				Config: testAccS3BucketNotificationConfig_updated(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketNotificationTopics("huaweicloud_s3_bucket_notification.notification", 2),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket_notification.notification", "topic.1.events.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckS3BucketNotificationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	conn, err := config.computeS3conn(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_s3_bucket_notification" {
+			continue
+		}
+
+		out, err := conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			// The bucket is destroyed along with the notification.
+			continue
+		}
+		if len(out.TopicConfigurations) > 0 {
+			return fmt.Errorf("S3 bucket %s still has notification configurations", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckS3BucketNotificationTopics(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+		}
+
+		out, err := conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("GetBucketNotificationConfiguration error: %v", err)
+		}
+		if len(out.TopicConfigurations) != count {
+			return fmt.Errorf("Expected %d topic configurations, got %d", count, len(out.TopicConfigurations))
+		}
+
+		return nil
+	}
+}
+
+func testAccS3BucketNotificationConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_smn_topic_v2" "topic_1" {
+  name = "tf-test-topic-%d"
+}
+
+resource "huaweicloud_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+}
+
+resource "huaweicloud_s3_bucket_notification" "notification" {
+  bucket = "${huaweicloud_s3_bucket.bucket.bucket}"
+
+  topic {
+    id            = "notification-1"
+    topic_urn     = "${huaweicloud_smn_topic_v2.topic_1.topic_urn}"
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "images/"
+    filter_suffix = ".jpg"
+  }
+}
+`, randInt, randInt)
+}
+
+func testAccS3BucketNotificationConfig_updated(randInt int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_smn_topic_v2" "topic_1" {
+  name = "tf-test-topic-%d"
+}
+
+resource "huaweicloud_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+}
+
+resource "huaweicloud_s3_bucket_notification" "notification" {
+  bucket = "${huaweicloud_s3_bucket.bucket.bucket}"
+
+  topic {
+    id            = "notification-1"
+    topic_urn     = "${huaweicloud_smn_topic_v2.topic_1.topic_urn}"
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "images/"
+    filter_suffix = ".jpg"
+  }
+
+  topic {
+    id        = "notification-2"
+    topic_urn = "${huaweicloud_smn_topic_v2.topic_1.topic_urn}"
+    events    = ["s3:ObjectRemoved:Delete", "s3:ObjectRemoved:DeleteMarkerCreated"]
+  }
+}
+`, randInt, randInt)
+}
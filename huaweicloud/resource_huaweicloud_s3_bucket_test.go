@@ -491,6 +491,94 @@ func TestAccS3Bucket_Logging(t *testing.T) {
 	})
 }
 
+func TestAccS3Bucket_ServerSideEncryptionKMS(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketConfigWithServerSideEncryptionKMS(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("huaweicloud_s3_bucket.bucket"),
+					testAccCheckS3BucketServerSideEncryption(
+						"huaweicloud_s3_bucket.bucket", "huaweicloud_kms_key_v1.key"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket.bucket",
+						"server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm",
+						"aws:kms"),
+				),
+			},
+			{
+				// Removing the configuration outside of Terraform must show
+				// up in the plan.
+				Config: testAccS3BucketConfigWithServerSideEncryptionKMS(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketDeleteServerSideEncryption("huaweicloud_s3_bucket.bucket"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccS3BucketConfigWithServerSideEncryptionKMS(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketServerSideEncryption(
+						"huaweicloud_s3_bucket.bucket", "huaweicloud_kms_key_v1.key"),
+				),
+			},
+			{
+				Config: testAccS3BucketConfigWithoutServerSideEncryption(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketServerSideEncryption(
+						"huaweicloud_s3_bucket.bucket", ""),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket.bucket", "server_side_encryption_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_Replication(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckS3Replication(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccS3BucketConfigReplicationWithoutVersioning(rInt),
+				ExpectError: regexp.MustCompile("versioning must be enabled"),
+			},
+			{
+				Config: testAccS3BucketConfigReplication(rInt, "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("huaweicloud_s3_bucket.bucket"),
+					testAccCheckS3BucketReplicationRules("huaweicloud_s3_bucket.bucket", 2),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket.bucket", "replication_configuration.0.agency", OS_AGENCY_NAME),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket.bucket", "replication_configuration.0.rules.#", "2"),
+				),
+			},
+			{
+				Config: testAccS3BucketConfigReplication(rInt, "STANDARD_IA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketReplicationRules("huaweicloud_s3_bucket.bucket", 2),
+				),
+			},
+			{
+				Config: testAccS3BucketConfigWithVersioningAndDestination(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketReplicationRules("huaweicloud_s3_bucket.bucket", 0),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_s3_bucket.bucket", "replication_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 // PASS
 func TestAccS3Bucket_Lifecycle(t *testing.T) {
 	rInt := acctest.RandInt()
@@ -631,6 +719,148 @@ func TestS3BucketName(t *testing.T) {
 	}
 }
 
+func TestS3BucketServerSideEncryptionConfiguration(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"rule": []interface{}{
+				map[string]interface{}{
+					"apply_server_side_encryption_by_default": []interface{}{
+						map[string]interface{}{
+							"sse_algorithm":     "aws:kms",
+							"kms_master_key_id": "key-id",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expanded := expandS3ServerSideEncryptionConfiguration(raw)
+	expected := &s3.ServerSideEncryptionConfiguration{
+		Rules: []*s3.ServerSideEncryptionRule{
+			{
+				ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+					SSEAlgorithm:   aws.String("aws:kms"),
+					KMSMasterKeyID: aws.String("key-id"),
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expanded, expected) {
+		t.Fatalf("Unexpected expanded configuration:\n got: %s\nwant: %s", expanded, expected)
+	}
+
+	flattened := flattenS3ServerSideEncryptionConfiguration(expanded)
+	if len(flattened) != 1 || !reflect.DeepEqual(flattened[0], raw[0]) {
+		t.Fatalf("Unexpected flattened configuration: %#v", flattened)
+	}
+
+	if v := flattenS3ServerSideEncryptionConfiguration(nil); len(v) != 0 {
+		t.Fatalf("Expected no configuration, got %#v", v)
+	}
+}
+
+func TestS3BucketReplicationConfiguration(t *testing.T) {
+	p := Provider().(*schema.Provider)
+	raw := map[string]interface{}{
+		"bucket": "tf-test-bucket",
+		"replication_configuration": []interface{}{
+			map[string]interface{}{
+				"agency": "replication-agency",
+				"rules": []interface{}{
+					map[string]interface{}{
+						"id":     "logs",
+						"prefix": "logs/",
+						"status": "Enabled",
+						"destination": []interface{}{
+							map[string]interface{}{
+								"bucket":        "tf-test-destination",
+								"storage_class": "STANDARD_IA",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, p.ResourcesMap["huaweicloud_s3_bucket"].Schema, raw)
+	if err := validateS3BucketReplicationConfiguration(d); err == nil {
+		t.Fatalf("Expected an error when versioning is not enabled")
+	}
+
+	raw["versioning"] = []interface{}{map[string]interface{}{"enabled": true}}
+	d = schema.TestResourceDataRaw(t, p.ResourcesMap["huaweicloud_s3_bucket"].Schema, raw)
+	if err := validateS3BucketReplicationConfiguration(d); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	replication := d.Get("replication_configuration").([]interface{})
+	expanded := expandS3ReplicationConfiguration(replication)
+	expected := &s3.ReplicationConfiguration{
+		Role: aws.String("replication-agency"),
+		Rules: []*s3.ReplicationRule{
+			{
+				ID:     aws.String("logs"),
+				Prefix: aws.String("logs/"),
+				Status: aws.String("Enabled"),
+				Destination: &s3.Destination{
+					Bucket:       aws.String("tf-test-destination"),
+					StorageClass: aws.String("STANDARD_IA"),
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expanded, expected) {
+		t.Fatalf("Unexpected expanded configuration:\n got: %s\nwant: %s", expanded, expected)
+	}
+
+	flattened := flattenS3ReplicationConfiguration(expanded)
+	if len(flattened) != 1 {
+		t.Fatalf("Unexpected flattened configuration: %#v", flattened)
+	}
+	rules := replication[0].(map[string]interface{})["rules"].(*schema.Set)
+	flattenedRules := flattened[0]["rules"].(*schema.Set)
+	if flattenedRules.Len() != rules.Len() || flattenedRules.Difference(rules).Len() != 0 {
+		t.Fatalf("Unexpected flattened rules:\n got: %#v\nwant: %#v", flattened[0]["rules"], rules)
+	}
+
+	// A priority on any rule switches all the rules to prefix filters.
+	raw["replication_configuration"].([]interface{})[0].(map[string]interface{})["rules"] = append(
+		raw["replication_configuration"].([]interface{})[0].(map[string]interface{})["rules"].([]interface{}),
+		map[string]interface{}{
+			"prefix":   "audit/",
+			"status":   "Enabled",
+			"priority": 2,
+			"destination": []interface{}{
+				map[string]interface{}{
+					"bucket": "tf-test-destination",
+				},
+			},
+		})
+	d = schema.TestResourceDataRaw(t, p.ResourcesMap["huaweicloud_s3_bucket"].Schema, raw)
+	expanded = expandS3ReplicationConfiguration(d.Get("replication_configuration").([]interface{}))
+	if len(expanded.Rules) != 2 {
+		t.Fatalf("Expected 2 rules, got %s", expanded)
+	}
+	for _, rule := range expanded.Rules {
+		if rule.Prefix != nil || rule.Filter == nil || rule.Priority == nil || rule.DeleteMarkerReplication == nil {
+			t.Fatalf("Expected a prioritized rule with a filter, got %s", rule)
+		}
+	}
+	input := &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String("tf-test-bucket"),
+		ReplicationConfiguration: expanded,
+	}
+	if err := input.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error: %s", err)
+	}
+
+	if v := flattenS3ReplicationConfiguration(nil); len(v) != 0 {
+		t.Fatalf("Expected no configuration, got %#v", v)
+	}
+}
+
 func testAccCheckS3BucketDestroy(s *terraform.State) error {
 	// UNDONE: Why instance check?
 	//return testAccCheckInstanceDestroyWithProvider(s, testAccProvider)
@@ -942,6 +1172,99 @@ func testAccCheckS3BucketLogging(n, b, p string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckS3BucketServerSideEncryption(n, keyResource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, _ := s.RootModule().Resources[n]
+		config := testAccProvider.Meta().(*Config)
+		conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+		}
+
+		out, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		if keyResource == "" {
+			if err == nil && out.ServerSideEncryptionConfiguration != nil &&
+				len(out.ServerSideEncryptionConfiguration.Rules) > 0 {
+				return fmt.Errorf("bad server side encryption, expected none, got %v", out)
+			}
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("GetBucketEncryption error: %v", err)
+		}
+
+		key, _ := s.RootModule().Resources[keyResource]
+		rules := out.ServerSideEncryptionConfiguration.Rules
+		if len(rules) != 1 || rules[0].ApplyServerSideEncryptionByDefault == nil {
+			return fmt.Errorf("bad server side encryption rules: %v", rules)
+		}
+		sse := rules[0].ApplyServerSideEncryptionByDefault
+		if v := aws.StringValue(sse.SSEAlgorithm); v != s3.ServerSideEncryptionAwsKms {
+			return fmt.Errorf("bad sse algorithm, expected: %s, got %s", s3.ServerSideEncryptionAwsKms, v)
+		}
+		if v := aws.StringValue(sse.KMSMasterKeyID); v != key.Primary.ID {
+			return fmt.Errorf("bad kms master key id, expected: %s, got %s", key.Primary.ID, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckS3BucketReplicationRules(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, _ := s.RootModule().Resources[n]
+		config := testAccProvider.Meta().(*Config)
+		conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+		}
+
+		out, err := conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 && count == 0 {
+				return nil
+			}
+			return fmt.Errorf("GetBucketReplication error: %v", err)
+		}
+
+		var rules []*s3.ReplicationRule
+		if out.ReplicationConfiguration != nil {
+			rules = out.ReplicationConfiguration.Rules
+		}
+		if len(rules) != count {
+			return fmt.Errorf("bad replication rules, expected %d rules, got %v", count, rules)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckS3BucketDeleteServerSideEncryption(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, _ := s.RootModule().Resources[n]
+		config := testAccProvider.Meta().(*Config)
+		conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud s3 client: %s", err)
+		}
+
+		_, err = conn.DeleteBucketEncryption(&s3.DeleteBucketEncryptionInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("DeleteBucketEncryption error: %v", err)
+		}
+
+		return nil
+	}
+}
+
 // These need a bit of randomness as the name can only be used once globally
 // within AWS
 func testAccBucketName(randInt int) string {
@@ -1230,6 +1553,127 @@ resource "huaweicloud_s3_bucket" "bucket" {
 `, randInt, randInt)
 }
 
+func testAccS3BucketConfigWithServerSideEncryptionKMS(randInt int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key_v1" "key" {
+	key_alias = "tf-test-key-%d"
+	pending_days = "7"
+}
+
+resource "huaweicloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+	server_side_encryption_configuration {
+		rule {
+			apply_server_side_encryption_by_default {
+				kms_master_key_id = "${huaweicloud_kms_key_v1.key.id}"
+				sse_algorithm = "aws:kms"
+			}
+		}
+	}
+}
+`, randInt, randInt)
+}
+
+func testAccS3BucketConfigWithoutServerSideEncryption(randInt int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key_v1" "key" {
+	key_alias = "tf-test-key-%d"
+	pending_days = "7"
+}
+
+resource "huaweicloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+}
+`, randInt, randInt)
+}
+
+func testAccS3BucketConfigReplicationDestination(randInt int) string {
+	return fmt.Sprintf(`
+provider "huaweicloud" {
+	alias = "destination"
+	region = "%s"
+}
+
+resource "huaweicloud_s3_bucket" "destination" {
+	provider = "huaweicloud.destination"
+	bucket = "tf-test-bucket-destination-%d"
+	region = "%s"
+	versioning {
+		enabled = true
+	}
+}
+`, OS_DEST_REGION_NAME, randInt, OS_DEST_REGION_NAME)
+}
+
+func testAccS3BucketConfigReplicationWithoutVersioning(randInt int) string {
+	return testAccS3BucketConfigReplicationDestination(randInt) + fmt.Sprintf(`
+resource "huaweicloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+
+	replication_configuration {
+		agency = "%s"
+		rules {
+			prefix = "audit/"
+			status = "Enabled"
+			destination {
+				bucket = "${huaweicloud_s3_bucket.destination.bucket}"
+			}
+		}
+	}
+}
+`, randInt, OS_AGENCY_NAME)
+}
+
+func testAccS3BucketConfigReplication(randInt int, storageClass string) string {
+	return testAccS3BucketConfigReplicationDestination(randInt) + fmt.Sprintf(`
+resource "huaweicloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+	versioning {
+		enabled = true
+	}
+
+	replication_configuration {
+		agency = "%s"
+		rules {
+			id = "audit"
+			prefix = "audit/"
+			status = "Enabled"
+			priority = 2
+			destination {
+				bucket = "${huaweicloud_s3_bucket.destination.bucket}"
+				storage_class = "%s"
+			}
+		}
+		rules {
+			id = "logs"
+			prefix = "logs/"
+			status = "Disabled"
+			priority = 1
+			destination {
+				bucket = "${huaweicloud_s3_bucket.destination.bucket}"
+			}
+		}
+	}
+}
+`, randInt, OS_AGENCY_NAME, storageClass)
+}
+
+func testAccS3BucketConfigWithVersioningAndDestination(randInt int) string {
+	return testAccS3BucketConfigReplicationDestination(randInt) + fmt.Sprintf(`
+resource "huaweicloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+	versioning {
+		enabled = true
+	}
+}
+`, randInt)
+}
+
 func testAccS3BucketConfigWithLifecycle(randInt int) string {
 	return fmt.Sprintf(`
 resource "huaweicloud_s3_bucket" "bucket" {
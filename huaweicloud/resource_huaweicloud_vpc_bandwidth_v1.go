@@ -0,0 +1,263 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v1/bandwidths"
+)
+
+func resourceVpcBandWidthV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcBandWidthV1Create,
+		Read:   resourceVpcBandWidthV1Read,
+		Update: resourceVpcBandWidthV1Update,
+		Delete: resourceVpcBandWidthV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"share_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"charge_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"publicips": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVpcBandWidthV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating networking client: %s", err)
+	}
+	bandwidthClient, err := config.networkingHwV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating networking v2 client: %s", err)
+	}
+
+	createOpts := BandwidthCreateOpts{
+		Name: d.Get("name").(string),
+		Size: d.Get("size").(int),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	bandWidth, err := createBandwidth(bandwidthClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating Bandwidth: %s", err)
+	}
+
+	d.SetId(bandWidth.ID)
+
+	log.Printf("[DEBUG] Waiting for Bandwidth %s to become available.", bandWidth.ID)
+
+	err = waitForBandWidthUpdate(networkingClient, bandWidth.ID, createOpts.Size, "", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for Bandwidth (%s) to become ready: %s",
+			bandWidth.ID, err)
+	}
+
+	return resourceVpcBandWidthV1Read(d, meta)
+}
+
+func resourceVpcBandWidthV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating networking client: %s", err)
+	}
+
+	bandWidth, err := getBandwidth(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Bandwidth")
+	}
+
+	log.Printf("[DEBUG] Retrieved Bandwidth %s: %+v", d.Id(), bandWidth)
+
+	publicIPs := make([]map[string]interface{}, 0, len(bandWidth.PublicIPInfo))
+	for _, ip := range bandWidth.PublicIPInfo {
+		publicIPs = append(publicIPs, map[string]interface{}{
+			"id":         ip.PublicIPID,
+			"ip_address": ip.PublicIPAddress,
+			"type":       ip.PublicIPType,
+		})
+	}
+
+	d.Set("name", bandWidth.Name)
+	d.Set("size", bandWidth.Size)
+	d.Set("share_type", bandWidth.ShareType)
+	d.Set("bandwidth_type", bandWidth.BandwidthType)
+	d.Set("charge_mode", bandWidth.ChargeMode)
+	if err := d.Set("publicips", publicIPs); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving publicips to state for Bandwidth (%s): %s", d.Id(), err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpcBandWidthV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating networking client: %s", err)
+	}
+
+	if d.HasChange("name") || d.HasChange("size") {
+		updateOpts := bandwidths.UpdateOpts{
+			Name: d.Get("name").(string),
+			Size: d.Get("size").(int),
+		}
+
+		log.Printf("[DEBUG] Bandwidth Update Options: %#v", updateOpts)
+		_, err = bandwidths.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating Bandwidth: %s", err)
+		}
+
+		err = waitForBandWidthUpdate(networkingClient, d.Id(), updateOpts.Size, "", d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error waiting for Bandwidth (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVpcBandWidthV1Read(d, meta)
+}
+
+func resourceVpcBandWidthV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating networking client: %s", err)
+	}
+	bandwidthClient, err := config.networkingHwV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating networking v2 client: %s", err)
+	}
+
+	err = deleteBandwidth(bandwidthClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Bandwidth")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    getBandWidthDeleted(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting Bandwidth: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getBandWidthDeleted(networkingClient *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		b, err := bandwidths.Get(networkingClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted Bandwidth %s", id)
+				return b, "DELETED", nil
+			}
+			return b, "ACTIVE", err
+		}
+
+		log.Printf("[DEBUG] Bandwidth %s still active.", id)
+		return b, "ACTIVE", nil
+	}
+}
+
+// getBandWidthUpdated reports the bandwidth as ACTIVE once its size and, if
+// set, its charge mode have the given values.
+func getBandWidthUpdated(networkingClient *golangsdk.ServiceClient, id string, size int, chargeMode string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		b, err := bandwidths.Get(networkingClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Bandwidth: %+v", b)
+		if b.Size == size && (chargeMode == "" || b.ChargeMode == chargeMode) {
+			return b, "ACTIVE", nil
+		}
+
+		return b, "PENDING", nil
+	}
+}
+
+func waitForBandWidthUpdate(networkingClient *golangsdk.ServiceClient, id string, size int, chargeMode string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    getBandWidthUpdated(networkingClient, id, size, chargeMode),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
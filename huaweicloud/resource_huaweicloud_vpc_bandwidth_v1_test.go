@@ -0,0 +1,163 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/huaweicloud/golangsdk/openstack/networking/v1/bandwidths"
+)
+
+func TestAccVpcV1BandWidth_basic(t *testing.T) {
+	var bandWidth bandwidths.BandWidth
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcV1BandWidthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcV1BandWidth_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1BandWidthExists("huaweicloud_vpc_bandwidth_v1.bandwidth_1", &bandWidth),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_bandwidth_v1.bandwidth_1", "name", "bandwidth_1"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_bandwidth_v1.bandwidth_1", "size", "5"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_bandwidth_v1.bandwidth_1", "share_type", "WHOLE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcV1BandWidth_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1BandWidthExists("huaweicloud_vpc_bandwidth_v1.bandwidth_1", &bandWidth),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_bandwidth_v1.bandwidth_1", "name", "bandwidth_1_updated"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_bandwidth_v1.bandwidth_1", "size", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVpcV1BandWidth_publicips(t *testing.T) {
+	var bandWidth bandwidths.BandWidth
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcV1BandWidthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcV1BandWidth_publicips,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1BandWidthExists("huaweicloud_vpc_bandwidth_v1.bandwidth_1", &bandWidth),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.share_type", "WHOLE"),
+					resource.TestCheckResourceAttrPair(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.id",
+						"huaweicloud_vpc_bandwidth_v1.bandwidth_1", "id"),
+				),
+			},
+			resource.TestStep{
+				// Refresh the bandwidth once the EIP has joined it.
+				Config: testAccVpcV1BandWidth_publicips,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_bandwidth_v1.bandwidth_1", "publicips.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"huaweicloud_vpc_bandwidth_v1.bandwidth_1", "publicips.0.id",
+						"huaweicloud_vpc_eip_v1.eip_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcV1BandWidthDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_vpc_bandwidth_v1" {
+			continue
+		}
+
+		_, err := bandwidths.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Bandwidth still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpcV1BandWidthExists(n string, bandWidth *bandwidths.BandWidth) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating networking client: %s", err)
+		}
+
+		found, err := bandwidths.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Bandwidth not found")
+		}
+
+		*bandWidth = found
+
+		return nil
+	}
+}
+
+const testAccVpcV1BandWidth_basic = `
+resource "huaweicloud_vpc_bandwidth_v1" "bandwidth_1" {
+  name = "bandwidth_1"
+  size = 5
+}
+`
+
+const testAccVpcV1BandWidth_update = `
+resource "huaweicloud_vpc_bandwidth_v1" "bandwidth_1" {
+  name = "bandwidth_1_updated"
+  size = 6
+}
+`
+
+const testAccVpcV1BandWidth_publicips = `
+resource "huaweicloud_vpc_bandwidth_v1" "bandwidth_1" {
+  name = "bandwidth_1"
+  size = 5
+}
+
+resource "huaweicloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    id = "${huaweicloud_vpc_bandwidth_v1.bandwidth_1.id}"
+    share_type = "WHOLE"
+  }
+}
+`
@@ -25,6 +25,7 @@ func resourceVpcEIPV1() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -69,23 +70,34 @@ func resourceVpcEIPV1() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"name": &schema.Schema{
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: false,
+							Computed: true,
 						},
 						"size": &schema.Schema{
 							Type:     schema.TypeInt,
-							Required: true,
+							Optional: true,
 							ForceNew: false,
+							Computed: true,
 						},
 						"share_type": &schema.Schema{
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
+							ForceNew: false,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"PER", "WHOLE"})
+							},
+						},
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: false,
+							Computed: true,
 						},
 						"charge_mode": &schema.Schema{
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: false,
 							Computed: true,
 						},
 					},
@@ -107,10 +119,15 @@ func resourceVpcEIPV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating networking client: %s", err)
 	}
 
+	bandWidth, err := resourceBandWidth(d)
+	if err != nil {
+		return err
+	}
+
 	createOpts := EIPCreateOpts{
-		eips.ApplyOpts{
+		EIPApplyOpts{
 			IP:        resourcePublicIP(d),
-			Bandwidth: resourceBandWidth(d),
+			Bandwidth: bandWidth,
 		},
 		MapValueSpecs(d),
 	}
@@ -173,6 +190,7 @@ func resourceVpcEIPV1Read(d *schema.ResourceData, meta interface{}) error {
 			"name":        bandWidth.Name,
 			"size":        eIP.BandwidthSize,
 			"share_type":  eIP.BandwidthShareType,
+			"id":          eIP.BandwidthID,
 			"charge_mode": bandWidth.ChargeMode,
 		},
 	}
@@ -191,24 +209,10 @@ func resourceVpcEIPV1Update(d *schema.ResourceData, meta interface{}) error {
 
 	// Update bandwidth change
 	if d.HasChange("bandwidth") {
-		var updateOpts bandwidths.UpdateOpts
-
-		newBWList := d.Get("bandwidth").([]interface{})
-		newMap := newBWList[0].(map[string]interface{})
-		updateOpts.Size = newMap["size"].(int)
-		updateOpts.Name = newMap["name"].(string)
-
-		log.Printf("[DEBUG] Bandwidth Update Options: %#v", updateOpts)
-
-		eIP, err := eips.Get(networkingClient, d.Id()).Extract()
-		if err != nil {
-			return CheckDeleted(d, err, "eIP")
-		}
-		_, err = bandwidths.Update(networkingClient, eIP.BandwidthID, updateOpts).Extract()
+		err = updateEIPBandWidth(d, config, networkingClient)
 		if err != nil {
-			return fmt.Errorf("Error updating bandwidth: %s", err)
+			return err
 		}
-
 	}
 
 	// Update publicip change
@@ -316,17 +320,187 @@ func resourcePublicIP(d *schema.ResourceData) eips.PublicIpOpts {
 	return publicip
 }
 
-func resourceBandWidth(d *schema.ResourceData) eips.BandwidthOpts {
+func resourceBandWidth(d *schema.ResourceData) (EIPBandwidthOpts, error) {
 	bandwidthRaw := d.Get("bandwidth").([]interface{})
 	rawMap := bandwidthRaw[0].(map[string]interface{})
 
-	bandwidth := eips.BandwidthOpts{
+	// A shared bandwidth is only referenced by its id.
+	if rawMap["share_type"].(string) == "WHOLE" {
+		if rawMap["id"].(string) == "" {
+			return EIPBandwidthOpts{}, fmt.Errorf("bandwidth id is required when share_type is WHOLE")
+		}
+		return EIPBandwidthOpts{
+			ID:        rawMap["id"].(string),
+			ShareType: "WHOLE",
+		}, nil
+	}
+
+	if rawMap["name"].(string) == "" || rawMap["size"].(int) == 0 {
+		return EIPBandwidthOpts{}, fmt.Errorf("bandwidth name and size are required when share_type is PER")
+	}
+
+	bandwidth := EIPBandwidthOpts{
 		Name:       rawMap["name"].(string),
 		Size:       rawMap["size"].(int),
 		ShareType:  rawMap["share_type"].(string),
 		ChargeMode: rawMap["charge_mode"].(string),
 	}
-	return bandwidth
+	return bandwidth, nil
+}
+
+// updateEIPBandWidth moves the EIP in or out of a shared bandwidth, or
+// resizes its dedicated bandwidth.
+func updateEIPBandWidth(d *schema.ResourceData, config *Config, networkingClient *golangsdk.ServiceClient) error {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	oldRaw, newRaw := d.GetChange("bandwidth")
+	oldMap := oldRaw.([]interface{})[0].(map[string]interface{})
+	newMap := newRaw.([]interface{})[0].(map[string]interface{})
+
+	oldShareType, newShareType := oldMap["share_type"].(string), newMap["share_type"].(string)
+	oldID, newID := oldMap["id"].(string), newMap["id"].(string)
+	publicIPType := d.Get("publicip.0.type").(string)
+
+	if oldShareType == "WHOLE" && (newShareType == "PER" || newID != oldID) {
+		bandwidthClient, err := config.networkingHwV2Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating networking v2 client: %s", err)
+		}
+
+		removeOpts := BandwidthRemoveOpts{
+			PublicIPInfo: []BandwidthPublicIP{{PublicIPID: d.Id(), PublicIPType: publicIPType}},
+			ChargeMode:   newMap["charge_mode"].(string),
+			Size:         newMap["size"].(int),
+		}
+		if newShareType == "WHOLE" {
+			// The EIP only keeps this dedicated bandwidth until it joins
+			// the new shared one.
+			removeOpts.ChargeMode = "bandwidth"
+			removeOpts.Size = 1
+		}
+		if removeOpts.ChargeMode == "" {
+			removeOpts.ChargeMode = "bandwidth"
+		}
+
+		log.Printf("[DEBUG] Removing EIP %s from Bandwidth %s: %#v", d.Id(), oldID, removeOpts)
+		err = removeBandwidthPublicIPs(bandwidthClient, oldID, removeOpts).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error removing EIP %s from Bandwidth %s: %s", d.Id(), oldID, err)
+		}
+
+		err = waitForEIPBandWidth(networkingClient, d.Id(), "PER", "", timeout)
+		if err != nil {
+			return fmt.Errorf("Error waiting for EIP %s to leave Bandwidth %s: %s", d.Id(), oldID, err)
+		}
+	}
+
+	if newShareType == "WHOLE" {
+		if oldShareType == "WHOLE" && newID == oldID {
+			if d.HasChange("bandwidth.0.name") || d.HasChange("bandwidth.0.size") || d.HasChange("bandwidth.0.charge_mode") {
+				return fmt.Errorf("The shared bandwidth %s of EIP %s must be changed through its huaweicloud_vpc_bandwidth_v1 resource", newID, d.Id())
+			}
+			return nil
+		}
+
+		bandwidthClient, err := config.networkingHwV2Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating networking v2 client: %s", err)
+		}
+
+		insertOpts := BandwidthInsertOpts{
+			PublicIPInfo: []BandwidthPublicIP{{PublicIPID: d.Id(), PublicIPType: publicIPType}},
+		}
+
+		log.Printf("[DEBUG] Adding EIP %s to Bandwidth %s: %#v", d.Id(), newID, insertOpts)
+		err = insertBandwidthPublicIPs(bandwidthClient, newID, insertOpts).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error adding EIP %s to Bandwidth %s: %s", d.Id(), newID, err)
+		}
+
+		err = waitForEIPBandWidth(networkingClient, d.Id(), "WHOLE", newID, timeout)
+		if err != nil {
+			return fmt.Errorf("Error waiting for EIP %s to join Bandwidth %s: %s", d.Id(), newID, err)
+		}
+
+		return nil
+	}
+
+	return resizeEIPBandWidth(d.Id(), networkingClient, newMap, timeout)
+}
+
+// resizeEIPBandWidth updates the dedicated bandwidth of the EIP to the
+// values of bandwidthMap which differ, and waits for the change to apply.
+func resizeEIPBandWidth(eipID string, networkingClient *golangsdk.ServiceClient, bandwidthMap map[string]interface{}, timeout time.Duration) error {
+	eIP, err := eips.Get(networkingClient, eipID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error fetching EIP %s: %s", eipID, err)
+	}
+	bandWidth, err := bandwidths.Get(networkingClient, eIP.BandwidthID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error fetching bandwidth: %s", err)
+	}
+
+	var updateOpts BandwidthUpdateOpts
+	if name := bandwidthMap["name"].(string); name != "" && name != bandWidth.Name {
+		updateOpts.Name = name
+	}
+	if size := bandwidthMap["size"].(int); size != 0 && size != bandWidth.Size {
+		updateOpts.Size = size
+	}
+	if chargeMode := bandwidthMap["charge_mode"].(string); chargeMode != "" && chargeMode != bandWidth.ChargeMode {
+		updateOpts.ChargeMode = chargeMode
+	}
+	if updateOpts == (BandwidthUpdateOpts{}) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Bandwidth Update Options: %#v", updateOpts)
+	_, err = bandwidths.Update(networkingClient, bandWidth.ID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating bandwidth: %s", err)
+	}
+
+	size := bandWidth.Size
+	if updateOpts.Size != 0 {
+		size = updateOpts.Size
+	}
+	err = waitForBandWidthUpdate(networkingClient, bandWidth.ID, size, updateOpts.ChargeMode, timeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting for bandwidth %s to be updated: %s", bandWidth.ID, err)
+	}
+
+	return nil
+}
+
+// getEIPBandWidth reports the EIP as ACTIVE once it uses a bandwidth of the
+// given share type and, if set, id.
+func getEIPBandWidth(networkingClient *golangsdk.ServiceClient, eipID, shareType, bandwidthID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		e, err := eips.Get(networkingClient, eipID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] EIP: %+v", e)
+		if e.BandwidthShareType == shareType && (bandwidthID == "" || e.BandwidthID == bandwidthID) {
+			return e, "ACTIVE", nil
+		}
+
+		return e, "PENDING", nil
+	}
+}
+
+func waitForEIPBandWidth(networkingClient *golangsdk.ServiceClient, eipID, shareType, bandwidthID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    getEIPBandWidth(networkingClient, eipID, shareType, bandwidthID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
 }
 
 func bindToPort(d *schema.ResourceData, eipID string, networkingClient *golangsdk.ServiceClient, timeout time.Duration) error {
@@ -46,6 +46,79 @@ func TestAccVpcV1EIP_timeout(t *testing.T) {
 	})
 }
 
+func TestAccVpcV1EIP_update(t *testing.T) {
+	var eip eips.PublicIp
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcV1EIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcV1EIP_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists("huaweicloud_vpc_eip_v1.eip_1", &eip),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.size", "8"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcV1EIP_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists("huaweicloud_vpc_eip_v1.eip_1", &eip),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.name", "test_updated"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.size", "10"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.charge_mode", "bandwidth"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVpcV1EIP_shareBandWidth(t *testing.T) {
+	var eip eips.PublicIp
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcV1EIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcV1EIP_dedicated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists("huaweicloud_vpc_eip_v1.eip_1", &eip),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.share_type", "PER"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcV1EIP_shared,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists("huaweicloud_vpc_eip_v1.eip_1", &eip),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.share_type", "WHOLE"),
+					resource.TestCheckResourceAttrPair(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.id",
+						"huaweicloud_vpc_bandwidth_v1.bandwidth_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcV1EIP_dedicated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists("huaweicloud_vpc_eip_v1.eip_1", &eip),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.share_type", "PER"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_eip_v1.eip_1", "bandwidth.0.size", "8"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVpcV1EIPDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV1Client(OS_REGION_NAME)
@@ -130,3 +203,53 @@ resource "huaweicloud_vpc_eip_v1" "eip_1" {
   }
 }
 `
+
+const testAccVpcV1EIP_update = `
+resource "huaweicloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name = "test_updated"
+    size = 10
+    share_type = "PER"
+    charge_mode = "bandwidth"
+  }
+}
+`
+
+const testAccVpcV1EIP_dedicated = `
+resource "huaweicloud_vpc_bandwidth_v1" "bandwidth_1" {
+  name = "bandwidth_1"
+  size = 5
+}
+
+resource "huaweicloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name = "test"
+    size = 8
+    share_type = "PER"
+    charge_mode = "traffic"
+  }
+}
+`
+
+const testAccVpcV1EIP_shared = `
+resource "huaweicloud_vpc_bandwidth_v1" "bandwidth_1" {
+  name = "bandwidth_1"
+  size = 5
+}
+
+resource "huaweicloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    id = "${huaweicloud_vpc_bandwidth_v1.bandwidth_1.id}"
+    share_type = "WHOLE"
+  }
+}
+`
@@ -0,0 +1,155 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+func resourceVpcPeeringConnectionAccepterV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcPeeringConnectionAccepterV2Create,
+		Read:   resourceVpcPeeringConnectionAccepterV2Read,
+		Delete: resourceVpcPeeringConnectionAccepterV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpc_peering_connection_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"accept": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpcPeeringConnectionAccepterV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingHwV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud peering client: %s", err)
+	}
+
+	id := d.Get("vpc_peering_connection_id").(string)
+	accept := d.Get("accept").(bool)
+
+	peering, err := getVpcPeering(peeringClient, id).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving HuaweiCloud VPC Peering Connection %s: %s", id, err)
+	}
+
+	target := "REJECTED"
+	if accept {
+		target = "ACTIVE"
+	}
+
+	switch {
+	case peering.Status == "PENDING_ACCEPTANCE" && accept:
+		log.Printf("[DEBUG] Accepting VPC Peering Connection %s", id)
+		_, err = acceptVpcPeering(peeringClient, id).Extract()
+	case peering.Status == "PENDING_ACCEPTANCE":
+		log.Printf("[DEBUG] Rejecting VPC Peering Connection %s", id)
+		_, err = rejectVpcPeering(peeringClient, id).Extract()
+	case peering.Status != target:
+		return fmt.Errorf("VPC Peering Connection %s is in %s state and can not be accepted or rejected", id, peering.Status)
+	}
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud VPC Peering Connection %s: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud VPC Peering Connection (%s) to become %s.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING_ACCEPTANCE"},
+		Target:     []string{target},
+		Refresh:    waitForVpcPeeringConnectionAccepted(peeringClient, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud VPC Peering Connection (%s) to become %s: %s", id, target, err)
+	}
+
+	return resourceVpcPeeringConnectionAccepterV2Read(d, meta)
+}
+
+func resourceVpcPeeringConnectionAccepterV2Read(d *schema.ResourceData, meta interface{}) error {
+	err := resourceVpcPeeringConnectionV2Read(d, meta)
+	if err != nil || d.Id() == "" {
+		return err
+	}
+
+	d.Set("vpc_peering_connection_id", d.Id())
+	d.Set("accept", d.Get("status").(string) == "ACTIVE")
+
+	return nil
+}
+
+func resourceVpcPeeringConnectionAccepterV2Delete(d *schema.ResourceData, meta interface{}) error {
+	// The peering connection belongs to the requester, so it is only removed
+	// from the state here.
+	log.Printf("[WARN] Will not delete VPC Peering Connection %s. Terraform will remove it from the state, but it will remain in HuaweiCloud.", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func waitForVpcPeeringConnectionAccepted(peeringClient *golangsdk.ServiceClient, peeringID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		p, err := getVpcPeering(peeringClient, peeringID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud VPC Peering Connection: %+v", p)
+		return p, p.Status, nil
+	}
+}
@@ -0,0 +1,246 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+func resourceVpcPeeringConnectionV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcPeeringConnectionV2Create,
+		Read:   resourceVpcPeeringConnectionV2Read,
+		Update: resourceVpcPeeringConnectionV2Update,
+		Delete: resourceVpcPeeringConnectionV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"peer_vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"peer_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpcPeeringConnectionV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingHwV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud peering client: %s", err)
+	}
+
+	peerTenantID := d.Get("peer_tenant_id").(string)
+	createOpts := VpcPeeringCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		RequestVpcInfo: VpcPeeringVpcInfo{
+			VpcId: d.Get("vpc_id").(string),
+		},
+		AcceptVpcInfo: VpcPeeringVpcInfo{
+			VpcId:    d.Get("peer_vpc_id").(string),
+			TenantId: peerTenantID,
+		},
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	peering, err := createVpcPeering(peeringClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating VPC Peering Connection: %s", err)
+	}
+
+	d.SetId(peering.ID)
+
+	// A peering connection with another tenant stays pending until that
+	// tenant accepts it, see huaweicloud_vpc_peering_connection_accepter_v2.
+	target := []string{"ACTIVE"}
+	if peerTenantID != "" && peerTenantID != peeringClient.ProjectID {
+		target = append(target, "PENDING_ACCEPTANCE")
+	}
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud VPC Peering Connection (%s) to become available.", peering.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING"},
+		Target:     target,
+		Refresh:    waitForVpcPeeringConnectionActive(peeringClient, peering.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud VPC Peering Connection (%s) to become available: %s", peering.ID, err)
+	}
+
+	return resourceVpcPeeringConnectionV2Read(d, meta)
+}
+
+func resourceVpcPeeringConnectionV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingHwV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud peering client: %s", err)
+	}
+
+	peering, err := getVpcPeering(peeringClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPC Peering Connection")
+	}
+
+	log.Printf("[DEBUG] Retrieved VPC Peering Connection %s: %+v", d.Id(), peering)
+
+	d.Set("name", peering.Name)
+	d.Set("description", peering.Description)
+	d.Set("vpc_id", peering.RequestVpcInfo.VpcId)
+	d.Set("peer_vpc_id", peering.AcceptVpcInfo.VpcId)
+	d.Set("peer_tenant_id", peering.AcceptVpcInfo.TenantId)
+	d.Set("status", peering.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpcPeeringConnectionV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingHwV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud peering client: %s", err)
+	}
+
+	var updateOpts VpcPeeringUpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] Updating VPC Peering Connection %s with options: %#v", d.Id(), updateOpts)
+	_, err = updateVpcPeering(peeringClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud VPC Peering Connection: %s", err)
+	}
+
+	return resourceVpcPeeringConnectionV2Read(d, meta)
+}
+
+func resourceVpcPeeringConnectionV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingHwV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud peering client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForVpcPeeringConnectionDelete(peeringClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud VPC Peering Connection: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForVpcPeeringConnectionActive(peeringClient *golangsdk.ServiceClient, peeringID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		p, err := getVpcPeering(peeringClient, peeringID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud VPC Peering Connection: %+v", p)
+		switch p.Status {
+		case "ACTIVE", "PENDING_ACCEPTANCE":
+			return p, p.Status, nil
+		case "REJECTED", "EXPIRED", "DELETED":
+			return p, p.Status, fmt.Errorf("VPC Peering Connection %s is in %s state", peeringID, p.Status)
+		}
+
+		return p, "CREATING", nil
+	}
+}
+
+func waitForVpcPeeringConnectionDelete(peeringClient *golangsdk.ServiceClient, peeringID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete HuaweiCloud VPC Peering Connection %s.\n", peeringID)
+
+		p, err := getVpcPeering(peeringClient, peeringID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud VPC Peering Connection %s", peeringID)
+				return p, "DELETED", nil
+			}
+			return p, "ACTIVE", err
+		}
+
+		if p.Status == "DELETED" {
+			log.Printf("[DEBUG] Successfully deleted HuaweiCloud VPC Peering Connection %s", peeringID)
+			return p, "DELETED", nil
+		}
+
+		err = deleteVpcPeering(peeringClient, peeringID).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud VPC Peering Connection %s", peeringID)
+				return p, "DELETED", nil
+			}
+			return p, "ACTIVE", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud VPC Peering Connection %s still active.\n", peeringID)
+		return p, "ACTIVE", nil
+	}
+}
@@ -0,0 +1,189 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccVpcPeeringConnectionV2_basic(t *testing.T) {
+	var peering VpcPeering
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcPeeringConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcPeeringConnectionV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringConnectionV2Exists("huaweicloud_vpc_peering_connection_v2.peering_1", &peering),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_peering_connection_v2.peering_1", "name", "peering_1"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_peering_connection_v2.peering_1", "status", "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcPeeringConnectionV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringConnectionV2Exists("huaweicloud_vpc_peering_connection_v2.peering_1", &peering),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_peering_connection_v2.peering_1", "name", "peering_1_updated"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_peering_connection_v2.peering_1", "description", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVpcPeeringConnectionV2_route(t *testing.T) {
+	var peering VpcPeering
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcPeeringConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcPeeringConnectionV2_route,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringConnectionV2Exists("huaweicloud_vpc_peering_connection_v2.peering_1", &peering),
+					resource.TestCheckResourceAttrPair(
+						"huaweicloud_networking_router_route_v2.route_1", "next_hop",
+						"huaweicloud_vpc_peering_connection_v2.peering_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVpcPeeringConnectionAccepterV2_basic(t *testing.T) {
+	var peering VpcPeering
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcPeeringConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcPeeringConnectionAccepterV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringConnectionV2Exists("huaweicloud_vpc_peering_connection_accepter_v2.accepter_1", &peering),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_peering_connection_accepter_v2.accepter_1", "accept", "true"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_peering_connection_accepter_v2.accepter_1", "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcPeeringConnectionV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	peeringClient, err := config.networkingHwV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud peering client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_vpc_peering_connection_v2" {
+			continue
+		}
+
+		p, err := getVpcPeering(peeringClient, rs.Primary.ID).Extract()
+		if err == nil && p.Status != "DELETED" {
+			return fmt.Errorf("VPC Peering Connection still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpcPeeringConnectionV2Exists(n string, peering *VpcPeering) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		peeringClient, err := config.networkingHwV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud peering client: %s", err)
+		}
+
+		found, err := getVpcPeering(peeringClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("VPC Peering Connection not found")
+		}
+
+		*peering = *found
+
+		return nil
+	}
+}
+
+const testAccVpcPeeringConnectionV2_vpcs = `
+resource "huaweicloud_vpc_v1" "vpc_1" {
+  name = "vpc_1"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_v1" "vpc_2" {
+  name = "vpc_2"
+  cidr = "172.16.0.0/16"
+}
+`
+
+var testAccVpcPeeringConnectionV2_basic = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpc_peering_connection_v2" "peering_1" {
+  name        = "peering_1"
+  vpc_id      = "${huaweicloud_vpc_v1.vpc_1.id}"
+  peer_vpc_id = "${huaweicloud_vpc_v1.vpc_2.id}"
+}
+`, testAccVpcPeeringConnectionV2_vpcs)
+
+var testAccVpcPeeringConnectionV2_update = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpc_peering_connection_v2" "peering_1" {
+  name        = "peering_1_updated"
+  description = "updated by terraform"
+  vpc_id      = "${huaweicloud_vpc_v1.vpc_1.id}"
+  peer_vpc_id = "${huaweicloud_vpc_v1.vpc_2.id}"
+}
+`, testAccVpcPeeringConnectionV2_vpcs)
+
+var testAccVpcPeeringConnectionV2_route = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_networking_router_route_v2" "route_1" {
+  router_id        = "${huaweicloud_vpc_v1.vpc_1.id}"
+  destination_cidr = "${huaweicloud_vpc_v1.vpc_2.cidr}"
+  next_hop         = "${huaweicloud_vpc_peering_connection_v2.peering_1.id}"
+}
+`, testAccVpcPeeringConnectionV2_basic)
+
+var testAccVpcPeeringConnectionAccepterV2_basic = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpc_peering_connection_accepter_v2" "accepter_1" {
+  vpc_peering_connection_id = "${huaweicloud_vpc_peering_connection_v2.peering_1.id}"
+  accept                    = true
+}
+`, testAccVpcPeeringConnectionV2_basic)
@@ -0,0 +1,222 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+)
+
+func resourceVpcRouteTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcRouteTableCreate,
+		Read:   resourceVpcRouteTableRead,
+		Update: resourceVpcRouteTableUpdate,
+		Delete: resourceVpcRouteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVpcRouteTableImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"router_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"next_hop": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVpcRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+	routerId := d.Get("router_id").(string)
+	if err := resourceVpcRouteTableApply(d, meta, routerId); err != nil {
+		return err
+	}
+
+	d.SetId(routerId)
+
+	return resourceVpcRouteTableRead(d, meta)
+}
+
+func resourceVpcRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	n, err := routers.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Route Table")
+	}
+
+	log.Printf("[DEBUG] Retrieved Router %s: %+v", d.Id(), n)
+
+	routes := make([]map[string]interface{}, 0, len(n.Routes))
+	for _, r := range n.Routes {
+		routes = append(routes, map[string]interface{}{
+			"destination_cidr": r.DestinationCIDR,
+			"next_hop":         r.NextHop,
+		})
+	}
+
+	d.Set("router_id", d.Id())
+	if err := d.Set("route", routes); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving routes to state for Route Table (%s): %s", d.Id(), err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpcRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceVpcRouteTableApply(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceVpcRouteTableRead(d, meta)
+}
+
+// resourceVpcRouteTableApply makes the route table of the router match the
+// configured routes.
+func resourceVpcRouteTableApply(d *schema.ResourceData, meta interface{}, routerId string) error {
+	osMutexKV.Lock(routerId)
+	defer osMutexKV.Unlock(routerId)
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	var routes []routers.Route
+	for _, raw := range d.Get("route").(*schema.Set).List() {
+		r := raw.(map[string]interface{})
+		routes = append(routes, routers.Route{
+			DestinationCIDR: r["destination_cidr"].(string),
+			NextHop:         r["next_hop"].(string),
+		})
+	}
+
+	return updateVpcRouteTable(networkingClient, routerId, routes)
+}
+
+func resourceVpcRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+	routerId := d.Id()
+	osMutexKV.Lock(routerId)
+	defer osMutexKV.Unlock(routerId)
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	if err := updateVpcRouteTable(networkingClient, routerId, nil); err != nil {
+		return CheckDeleted(d, err, "Route Table")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceVpcRouteTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("router_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateVpcRouteTable compares the live route table of the router with the
+// given routes and applies the additions and removals in a single update.
+// Routes which are kept stay in their current order.
+func updateVpcRouteTable(networkingClient *gophercloud.ServiceClient, routerId string, routes []routers.Route) error {
+	n, err := routers.Get(networkingClient, routerId).Extract()
+	if err != nil {
+		return err
+	}
+
+	additions, removals := diffVpcRoutes(n.Routes, routes)
+	if len(additions) == 0 && len(removals) == 0 {
+		log.Printf("[DEBUG] Route table of Router %s is up to date", routerId)
+		return nil
+	}
+
+	for _, r := range removals {
+		log.Printf("[INFO] Deleting route %s", r)
+	}
+	for _, r := range additions {
+		log.Printf("[INFO] Adding route %s", r)
+	}
+
+	newRts := []routers.Route{}
+	for _, r := range n.Routes {
+		if !containsVpcRoute(removals, r) {
+			newRts = append(newRts, r)
+		}
+	}
+	newRts = append(newRts, additions...)
+
+	updateOpts := routers.UpdateOpts{
+		Routes: newRts,
+	}
+
+	log.Printf("[DEBUG] Updating Router %s with options: %+v", routerId, updateOpts)
+	_, err = routers.Update(networkingClient, routerId, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud Neutron Router: %s", err)
+	}
+
+	return nil
+}
+
+// diffVpcRoutes returns the routes which have to be added to and removed from
+// the live route table to get the desired one.
+func diffVpcRoutes(live, desired []routers.Route) (additions, removals []routers.Route) {
+	for _, r := range desired {
+		if !containsVpcRoute(live, r) && !containsVpcRoute(additions, r) {
+			additions = append(additions, r)
+		}
+	}
+	for _, r := range live {
+		if !containsVpcRoute(desired, r) {
+			removals = append(removals, r)
+		}
+	}
+
+	return additions, removals
+}
+
+func containsVpcRoute(routes []routers.Route, route routers.Route) bool {
+	for _, r := range routes {
+		if r.DestinationCIDR == route.DestinationCIDR && r.NextHop == route.NextHop {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,233 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+)
+
+func TestDiffVpcRoutes(t *testing.T) {
+	r1 := routers.Route{DestinationCIDR: "172.16.0.0/16", NextHop: "192.168.0.10"}
+	r2 := routers.Route{DestinationCIDR: "172.17.0.0/16", NextHop: "192.168.0.11"}
+	r3 := routers.Route{DestinationCIDR: "172.18.0.0/16", NextHop: "192.168.0.12"}
+
+	cases := []struct {
+		live, desired       []routers.Route
+		additions, removals []routers.Route
+	}{
+		{
+			live:    []routers.Route{r1, r2},
+			desired: []routers.Route{r2, r1},
+		},
+		{
+			live:      nil,
+			desired:   []routers.Route{r1, r2},
+			additions: []routers.Route{r1, r2},
+		},
+		{
+			live:     []routers.Route{r1, r2},
+			desired:  nil,
+			removals: []routers.Route{r1, r2},
+		},
+		{
+			live:      []routers.Route{r1, r2},
+			desired:   []routers.Route{r2, r3, r3},
+			additions: []routers.Route{r3},
+			removals:  []routers.Route{r1},
+		},
+		{
+			live:      []routers.Route{r1},
+			desired:   []routers.Route{{DestinationCIDR: r1.DestinationCIDR, NextHop: r2.NextHop}},
+			additions: []routers.Route{{DestinationCIDR: r1.DestinationCIDR, NextHop: r2.NextHop}},
+			removals:  []routers.Route{r1},
+		},
+	}
+
+	for i, tc := range cases {
+		additions, removals := diffVpcRoutes(tc.live, tc.desired)
+		if !reflect.DeepEqual(additions, tc.additions) {
+			t.Errorf("case %d: expected additions %v, got %v", i, tc.additions, additions)
+		}
+		if !reflect.DeepEqual(removals, tc.removals) {
+			t.Errorf("case %d: expected removals %v, got %v", i, tc.removals, removals)
+		}
+	}
+}
+
+func TestAccVpcRouteTable_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcRouteTableDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcRouteTable_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcRouteTableRoutes("huaweicloud_vpc_route_table.table_1", 1),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_route_table.table_1", "route.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcRouteTable_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcRouteTableRoutes("huaweicloud_vpc_route_table.table_1", 2),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_vpc_route_table.table_1", "route.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVpcRouteTable_drift(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcRouteTableDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcRouteTable_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcRouteTableAddRoute("huaweicloud_vpc_route_table.table_1",
+						routers.Route{DestinationCIDR: "172.17.0.0/16", NextHop: "192.168.0.11"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				Config: testAccVpcRouteTable_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcRouteTableRoutes("huaweicloud_vpc_route_table.table_1", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcRouteTableDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_vpc_route_table" {
+			continue
+		}
+
+		n, err := routers.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil && len(n.Routes) != 0 {
+			return fmt.Errorf("Route table still has %d routes", len(n.Routes))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpcRouteTableRoutes(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+		}
+
+		found, err := routers.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if len(found.Routes) != count {
+			return fmt.Errorf("Expected %d routes, got %d", count, len(found.Routes))
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckVpcRouteTableAddRoute adds a route out-of-band to cause drift.
+func testAccCheckVpcRouteTableAddRoute(n string, route routers.Route) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+		}
+
+		found, err := routers.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		updateOpts := routers.UpdateOpts{
+			Routes: append(found.Routes, route),
+		}
+		_, err = routers.Update(networkingClient, rs.Primary.ID, updateOpts).Extract()
+		return err
+	}
+}
+
+const testAccVpcRouteTable_subnet = `
+resource "huaweicloud_vpc_v1" "vpc_1" {
+  name = "vpc_1"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet_v1" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = "${huaweicloud_vpc_v1.vpc_1.id}"
+}
+`
+
+var testAccVpcRouteTable_basic = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpc_route_table" "table_1" {
+  router_id = "${huaweicloud_vpc_subnet_v1.subnet_1.vpc_id}"
+
+  route {
+    destination_cidr = "172.16.0.0/16"
+    next_hop         = "192.168.0.10"
+  }
+}
+`, testAccVpcRouteTable_subnet)
+
+var testAccVpcRouteTable_update = fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpc_route_table" "table_1" {
+  router_id = "${huaweicloud_vpc_subnet_v1.subnet_1.vpc_id}"
+
+  route {
+    destination_cidr = "172.16.0.0/16"
+    next_hop         = "192.168.0.10"
+  }
+
+  route {
+    destination_cidr = "172.18.0.0/16"
+    next_hop         = "192.168.0.12"
+  }
+}
+`, testAccVpcRouteTable_subnet)
@@ -0,0 +1,281 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+func resourceVpcSubnetV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcSubnetV1Create,
+		Read:   resourceVpcSubnetV1Read,
+		Update: resourceVpcSubnetV1Update,
+		Delete: resourceVpcSubnetV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"gateway_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dhcp_enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"primary_dns": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"secondary_dns": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"dns_list": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpcSubnetV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	dhcpEnable := d.Get("dhcp_enable").(bool)
+	createOpts := VpcSubnetCreateOpts{
+		Name:             d.Get("name").(string),
+		CIDR:             d.Get("cidr").(string),
+		GatewayIP:        d.Get("gateway_ip").(string),
+		VpcID:            d.Get("vpc_id").(string),
+		DhcpEnable:       &dhcpEnable,
+		PrimaryDNS:       d.Get("primary_dns").(string),
+		SecondaryDNS:     d.Get("secondary_dns").(string),
+		DNSList:          resourceVpcSubnetV1DNSList(d),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	subnet, err := createVpcSubnet(vpcClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating Subnet: %s", err)
+	}
+
+	d.SetId(subnet.ID)
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud Subnet (%s) to become available.", subnet.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"UNKNOWN"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForVpcSubnetActive(vpcClient, subnet.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud Subnet (%s) to become available: %s", subnet.ID, err)
+	}
+
+	return resourceVpcSubnetV1Read(d, meta)
+}
+
+func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	subnet, err := getVpcSubnet(vpcClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Subnet")
+	}
+
+	log.Printf("[DEBUG] Retrieved Subnet %s: %+v", d.Id(), subnet)
+
+	d.Set("name", subnet.Name)
+	d.Set("cidr", subnet.CIDR)
+	d.Set("gateway_ip", subnet.GatewayIP)
+	d.Set("vpc_id", subnet.VpcID)
+	d.Set("dhcp_enable", subnet.DhcpEnable)
+	d.Set("primary_dns", subnet.PrimaryDNS)
+	d.Set("secondary_dns", subnet.SecondaryDNS)
+	d.Set("dns_list", subnet.DNSList)
+	d.Set("availability_zone", subnet.AvailabilityZone)
+	d.Set("subnet_id", subnet.NeutronSubnetID)
+	d.Set("status", subnet.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpcSubnetV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	// The name is always required, the other settings are replaced as a
+	// whole.
+	dhcpEnable := d.Get("dhcp_enable").(bool)
+	updateOpts := VpcSubnetUpdateOpts{
+		Name:         d.Get("name").(string),
+		DhcpEnable:   &dhcpEnable,
+		PrimaryDNS:   d.Get("primary_dns").(string),
+		SecondaryDNS: d.Get("secondary_dns").(string),
+		DNSList:      resourceVpcSubnetV1DNSList(d),
+	}
+
+	log.Printf("[DEBUG] Updating Subnet %s with options: %#v", d.Id(), updateOpts)
+	_, err = updateVpcSubnet(vpcClient, d.Get("vpc_id").(string), d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud Subnet: %s", err)
+	}
+
+	return resourceVpcSubnetV1Read(d, meta)
+}
+
+func resourceVpcSubnetV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForVpcSubnetDelete(vpcClient, d.Get("vpc_id").(string), d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud Subnet: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceVpcSubnetV1DNSList(d *schema.ResourceData) []string {
+	rawDNSList := d.Get("dns_list").([]interface{})
+	dnsList := make([]string, 0, len(rawDNSList))
+	for _, v := range rawDNSList {
+		dnsList = append(dnsList, v.(string))
+	}
+
+	return dnsList
+}
+
+func waitForVpcSubnetActive(vpcClient *golangsdk.ServiceClient, subnetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		s, err := getVpcSubnet(vpcClient, subnetID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud Subnet: %+v", s)
+		if s.Status == "ERROR" {
+			return s, s.Status, fmt.Errorf("Subnet %s is in ERROR state", subnetID)
+		}
+		if s.Status == "ACTIVE" {
+			return s, "ACTIVE", nil
+		}
+
+		return s, "UNKNOWN", nil
+	}
+}
+
+func waitForVpcSubnetDelete(vpcClient *golangsdk.ServiceClient, vpcID, subnetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete HuaweiCloud Subnet %s.\n", subnetID)
+
+		s, err := getVpcSubnet(vpcClient, subnetID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud Subnet %s", subnetID)
+				return s, "DELETED", nil
+			}
+			return s, "ACTIVE", err
+		}
+
+		err = deleteVpcSubnet(vpcClient, vpcID, subnetID).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud Subnet %s", subnetID)
+				return s, "DELETED", nil
+			}
+			// The ports of the subnet may still be being released.
+			if errCode, ok := err.(golangsdk.ErrUnexpectedResponseCode); ok && errCode.Actual == 409 {
+				return s, "ACTIVE", nil
+			}
+			return s, "ACTIVE", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud Subnet %s still active.\n", subnetID)
+		return s, "ACTIVE", nil
+	}
+}
@@ -0,0 +1,123 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccVpcSubnetV1_basic(t *testing.T) {
+	var subnet VpcSubnet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcSubnetV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcSubnetV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcSubnetV1Exists("huaweicloud_vpc_subnet_v1.subnet_1", &subnet),
+					resource.TestCheckResourceAttr("huaweicloud_vpc_subnet_v1.subnet_1", "name", "subnet_1"),
+					resource.TestCheckResourceAttr("huaweicloud_vpc_subnet_v1.subnet_1", "cidr", "192.168.0.0/24"),
+					resource.TestCheckResourceAttr("huaweicloud_vpc_subnet_v1.subnet_1", "gateway_ip", "192.168.0.1"),
+					resource.TestCheckResourceAttr("huaweicloud_vpc_subnet_v1.subnet_1", "status", "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcSubnetV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcSubnetV1Exists("huaweicloud_vpc_subnet_v1.subnet_1", &subnet),
+					resource.TestCheckResourceAttr("huaweicloud_vpc_subnet_v1.subnet_1", "name", "subnet_1_updated"),
+					resource.TestCheckResourceAttr("huaweicloud_vpc_subnet_v1.subnet_1", "primary_dns", "100.125.1.250"),
+					resource.TestCheckResourceAttr("huaweicloud_vpc_subnet_v1.subnet_1", "secondary_dns", "100.125.21.250"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcSubnetV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	vpcClient, err := config.networkingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_vpc_subnet_v1" {
+			continue
+		}
+
+		_, err := getVpcSubnet(vpcClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Subnet still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpcSubnetV1Exists(n string, subnet *VpcSubnet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		vpcClient, err := config.networkingV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+		}
+
+		found, err := getVpcSubnet(vpcClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Subnet not found")
+		}
+
+		*subnet = *found
+
+		return nil
+	}
+}
+
+const testAccVpcSubnetV1_basic = `
+resource "huaweicloud_vpc_v1" "vpc_1" {
+  name = "vpc_1"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet_v1" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id = "${huaweicloud_vpc_v1.vpc_1.id}"
+}
+`
+
+const testAccVpcSubnetV1_update = `
+resource "huaweicloud_vpc_v1" "vpc_1" {
+  name = "vpc_1"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet_v1" "subnet_1" {
+  name = "subnet_1_updated"
+  cidr = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id = "${huaweicloud_vpc_v1.vpc_1.id}"
+  primary_dns = "100.125.1.250"
+  secondary_dns = "100.125.21.250"
+}
+`
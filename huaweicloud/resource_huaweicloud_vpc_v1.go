@@ -0,0 +1,255 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+func resourceVpcV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcV1Create,
+		Read:   resourceVpcV1Read,
+		Update: resourceVpcV1Update,
+		Delete: resourceVpcV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"routes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nexthop": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVpcV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	createOpts := VpcCreateOpts{
+		Name: d.Get("name").(string),
+		CIDR: d.Get("cidr").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	vpc, err := createVpc(vpcClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating VPC: %s", err)
+	}
+
+	d.SetId(vpc.ID)
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud VPC (%s) to become available.", vpc.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING"},
+		Target:     []string{"OK"},
+		Refresh:    waitForVpcActive(vpcClient, vpc.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud VPC (%s) to become available: %s", vpc.ID, err)
+	}
+
+	return resourceVpcV1Read(d, meta)
+}
+
+func resourceVpcV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	vpc, err := getVpc(vpcClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPC")
+	}
+
+	log.Printf("[DEBUG] Retrieved VPC %s: %+v", d.Id(), vpc)
+
+	d.Set("name", vpc.Name)
+	d.Set("cidr", vpc.CIDR)
+	d.Set("status", vpc.Status)
+	if err := d.Set("routes", flattenVpcRoutes(vpc.Routes)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving routes to state for VPC (%s): %s", d.Id(), err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpcV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	var updateOpts VpcUpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("cidr") {
+		updateOpts.CIDR = d.Get("cidr").(string)
+	}
+
+	log.Printf("[DEBUG] Updating VPC %s with options: %#v", d.Id(), updateOpts)
+	_, err = updateVpc(vpcClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud VPC: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud VPC (%s) to become available.", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING"},
+		Target:     []string{"OK"},
+		Refresh:    waitForVpcActive(vpcClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud VPC (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceVpcV1Read(d, meta)
+}
+
+func resourceVpcV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcClient, err := config.networkingV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForVpcDelete(vpcClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud VPC: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flattenVpcRoutes(routes []VpcRoute) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(routes))
+	for _, route := range routes {
+		result = append(result, map[string]interface{}{
+			"destination": route.DestinationCIDR,
+			"nexthop":     route.NextHop,
+		})
+	}
+
+	return result
+}
+
+func waitForVpcActive(vpcClient *golangsdk.ServiceClient, vpcID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := getVpc(vpcClient, vpcID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud VPC: %+v", v)
+		if v.Status == "OK" {
+			return v, "OK", nil
+		}
+
+		return v, "CREATING", nil
+	}
+}
+
+func waitForVpcDelete(vpcClient *golangsdk.ServiceClient, vpcID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete HuaweiCloud VPC %s.\n", vpcID)
+
+		v, err := getVpc(vpcClient, vpcID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud VPC %s", vpcID)
+				return v, "DELETED", nil
+			}
+			return v, "ACTIVE", err
+		}
+
+		err = deleteVpc(vpcClient, vpcID).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud VPC %s", vpcID)
+				return v, "DELETED", nil
+			}
+			// The subnets of the VPC may still be being deleted.
+			if errCode, ok := err.(golangsdk.ErrUnexpectedResponseCode); ok && errCode.Actual == 409 {
+				return v, "ACTIVE", nil
+			}
+			return v, "ACTIVE", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud VPC %s still active.\n", vpcID)
+		return v, "ACTIVE", nil
+	}
+}
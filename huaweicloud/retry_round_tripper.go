@@ -0,0 +1,202 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// retryBaseWait is the wait before the first retry, doubled on every
+// following attempt up to the maximum wait.
+const retryBaseWait = 1 * time.Second
+
+// retryDefaultMaxWait is the maximum wait between two retries when MaxWait
+// is not set.
+const retryDefaultMaxWait = 60 * time.Second
+
+// RetryRoundTripper satisfies the http.RoundTripper interface and retries
+// requests which were throttled (429), failed on the server side (5xx) or
+// whose connection was reset, with an exponential backoff and jitter. See
+// isRetryableResponse for the failures which are not retried for POST.
+type RetryRoundTripper struct {
+	Rt         http.RoundTripper
+	MaxRetries int
+	MaxWait    time.Duration
+}
+
+// RoundTrip performs the request, retrying it up to MaxRetries times.
+func (rrt *RetryRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if rrt.MaxRetries <= 0 {
+		return rrt.Rt.RoundTrip(request)
+	}
+
+	// The body is consumed by every attempt, so keep a copy to replay.
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		req := new(http.Request)
+		*req = *request
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		response, err := rrt.Rt.RoundTrip(req)
+		if attempt >= rrt.MaxRetries || !isRetryableResponse(request.Method, response, err) {
+			return response, err
+		}
+
+		wait := rrt.backoff(attempt)
+		if response != nil {
+			if retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+				wait = retryAfter
+				if maxWait := rrt.maxWait(); wait > maxWait {
+					wait = maxWait
+				}
+			}
+
+			log.Printf("[DEBUG] Retrying %s %s after %s, got status %d (attempt %d of %d)",
+				request.Method, request.URL, wait, response.StatusCode, attempt+1, rrt.MaxRetries)
+
+			// Drain the body so that the connection can be reused.
+			io.Copy(ioutil.Discard, response.Body)
+			response.Body.Close()
+		} else {
+			log.Printf("[DEBUG] Retrying %s %s after %s, got error %s (attempt %d of %d)",
+				request.Method, request.URL, wait, err, attempt+1, rrt.MaxRetries)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-request.Context().Done():
+			timer.Stop()
+			return nil, request.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns a random wait between half and all of the exponential
+// backoff of the given attempt, capped at MaxWait.
+func (rrt *RetryRoundTripper) backoff(attempt int) time.Duration {
+	wait := rrt.maxWait()
+	if attempt < 30 {
+		if exp := retryBaseWait << uint(attempt); exp < wait {
+			wait = exp
+		}
+	}
+
+	half := int64(wait / 2)
+	if half <= 0 {
+		return wait
+	}
+
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// maxWait returns MaxWait, or retryDefaultMaxWait if it is not set, so that
+// retries never run without a delay.
+func (rrt *RetryRoundTripper) maxWait() time.Duration {
+	if rrt.MaxWait <= 0 {
+		return retryDefaultMaxWait
+	}
+	return rrt.MaxWait
+}
+
+// isRetryableResponse reports whether the request should be sent again.
+// Throttled (429) and unavailable (503) requests were refused before being
+// processed, so they are always retried. Other server errors and reset
+// connections may come after the server acted on the request, so they are
+// only retried for idempotent methods, lest a POST creates a resource twice.
+func isRetryableResponse(method string, response *http.Response, err error) bool {
+	if err != nil {
+		return isConnectionReset(err) && (isIdempotentMethod(method) || isDialError(err))
+	}
+
+	switch {
+	case response.StatusCode == http.StatusTooManyRequests:
+		return true
+	case response.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case response.StatusCode == http.StatusNotImplemented:
+		return false
+	case response.StatusCode >= 500:
+		return isIdempotentMethod(method)
+	}
+
+	return false
+}
+
+// isIdempotentMethod reports whether sending a request with the method
+// several times has the same effect as sending it once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+
+	return false
+}
+
+// isDialError reports whether err happened while connecting, before any
+// part of the request was sent.
+func isDialError(err error) bool {
+	e, ok := err.(*net.OpError)
+	return ok && e.Op == "dial"
+}
+
+// isConnectionReset reports whether err is, or wraps, ECONNRESET.
+func isConnectionReset(err error) bool {
+	for {
+		switch e := err.(type) {
+		case *net.OpError:
+			err = e.Err
+		case *os.SyscallError:
+			err = e.Err
+		case syscall.Errno:
+			return e == syscall.ECONNRESET
+		default:
+			return false
+		}
+	}
+}
+
+// parseRetryAfter parses a Retry-After header, given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(time.Now())
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
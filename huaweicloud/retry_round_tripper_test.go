@@ -0,0 +1,322 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type retryTestRoundTripper struct {
+	calls     int
+	responses []func(*http.Request) (*http.Response, error)
+	bodies    []string
+}
+
+func (rt *retryTestRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		body, _ = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+	}
+	rt.bodies = append(rt.bodies, string(body))
+
+	f := rt.responses[rt.calls]
+	rt.calls++
+	return f(req)
+}
+
+func retryTestResponse(status int, header http.Header) func(*http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     header,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			Request:    req,
+		}, nil
+	}
+}
+
+func retryTestError(err error) func(*http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		return nil, err
+	}
+}
+
+func TestRetryRoundTripper_retries(t *testing.T) {
+	reset := &net.OpError{
+		Op:  "read",
+		Net: "tcp",
+		Err: &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET},
+	}
+	dialReset := &net.OpError{
+		Op:  "dial",
+		Net: "tcp",
+		Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNRESET},
+	}
+
+	cases := []struct {
+		Name      string
+		Method    string
+		Responses []func(*http.Request) (*http.Response, error)
+		Calls     int
+		Status    int
+		Error     bool
+	}{
+		{
+			Method: "PUT",
+			Name:   "throttled",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestResponse(429, http.Header{"Retry-After": []string{"0"}}),
+				retryTestResponse(200, nil),
+			},
+			Calls:  2,
+			Status: 200,
+		},
+		{
+			Method: "PUT",
+			Name:   "server errors",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestResponse(500, nil),
+				retryTestResponse(502, nil),
+				retryTestResponse(201, nil),
+			},
+			Calls:  3,
+			Status: 201,
+		},
+		{
+			Method: "PUT",
+			Name:   "connection reset",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestError(reset),
+				retryTestResponse(200, nil),
+			},
+			Calls:  2,
+			Status: 200,
+		},
+		{
+			Method: "PUT",
+			Name:   "retries exhausted",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestResponse(503, nil),
+				retryTestResponse(503, nil),
+				retryTestResponse(503, nil),
+				retryTestResponse(503, nil),
+			},
+			Calls:  4,
+			Status: 503,
+		},
+		{
+			Method: "PUT",
+			Name:   "client error",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestResponse(404, nil),
+			},
+			Calls:  1,
+			Status: 404,
+		},
+		{
+			Method: "PUT",
+			Name:   "not implemented",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestResponse(501, nil),
+			},
+			Calls:  1,
+			Status: 501,
+		},
+		{
+			Method: "PUT",
+			Name:   "other error",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestError(fmt.Errorf("no such host")),
+			},
+			Calls: 1,
+			Error: true,
+		},
+		{
+			Name:   "post server error",
+			Method: "POST",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestResponse(500, nil),
+			},
+			Calls:  1,
+			Status: 500,
+		},
+		{
+			Name:   "post unavailable",
+			Method: "POST",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestResponse(503, nil),
+				retryTestResponse(429, nil),
+				retryTestResponse(201, nil),
+			},
+			Calls:  3,
+			Status: 201,
+		},
+		{
+			Name:   "post connection reset",
+			Method: "POST",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestError(reset),
+			},
+			Calls: 1,
+			Error: true,
+		},
+		{
+			Name:   "post connection reset while dialing",
+			Method: "POST",
+			Responses: []func(*http.Request) (*http.Response, error){
+				retryTestError(dialReset),
+				retryTestResponse(201, nil),
+			},
+			Calls:  2,
+			Status: 201,
+		},
+	}
+
+	for _, tc := range cases {
+		fake := &retryTestRoundTripper{responses: tc.Responses}
+		rt := &RetryRoundTripper{
+			Rt:         fake,
+			MaxRetries: 3,
+			MaxWait:    time.Millisecond,
+		}
+
+		req, _ := http.NewRequest(tc.Method, "https://vpc.example.com/v1/vpcs", bytes.NewReader([]byte(`{"vpc":{}}`)))
+		resp, err := rt.RoundTrip(req)
+
+		if fake.calls != tc.Calls {
+			t.Errorf("%s: expected %d calls, got %d", tc.Name, tc.Calls, fake.calls)
+		}
+		for i, body := range fake.bodies {
+			if body != `{"vpc":{}}` {
+				t.Errorf("%s: unexpected body of call %d: %q", tc.Name, i, body)
+			}
+		}
+
+		if tc.Error {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.Name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.Name, err)
+			continue
+		}
+		if resp.StatusCode != tc.Status {
+			t.Errorf("%s: expected status %d, got %d", tc.Name, tc.Status, resp.StatusCode)
+		}
+	}
+}
+
+func TestRetryRoundTripper_server(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("unexpected body: %q", body)
+		}
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{
+		Transport: &RetryRoundTripper{
+			Rt:         http.DefaultTransport,
+			MaxRetries: 5,
+			MaxWait:    time.Second,
+		},
+	}
+
+	resp, err := client.Post(ts.URL, "text/plain", bytes.NewReader([]byte("payload")))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryRoundTripper_backoff(t *testing.T) {
+	rt := &RetryRoundTripper{MaxWait: 10 * time.Second}
+
+	cases := []struct {
+		Attempt int
+		Max     time.Duration
+	}{
+		{0, 1 * time.Second},
+		{1, 2 * time.Second},
+		{2, 4 * time.Second},
+		{3, 8 * time.Second},
+		{4, 10 * time.Second},
+		{100, 10 * time.Second},
+	}
+
+	for _, tc := range cases {
+		for i := 0; i < 10; i++ {
+			wait := rt.backoff(tc.Attempt)
+			if wait < tc.Max/2 || wait > tc.Max {
+				t.Errorf("attempt %d: expected a wait between %s and %s, got %s", tc.Attempt, tc.Max/2, tc.Max, wait)
+			}
+		}
+	}
+}
+
+func TestRetryRoundTripper_backoffWithoutMaxWait(t *testing.T) {
+	rt := &RetryRoundTripper{}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		if wait := rt.backoff(attempt); wait <= 0 || wait > retryDefaultMaxWait {
+			t.Errorf("attempt %d: expected a wait between 0 and %s, got %s", attempt, retryDefaultMaxWait, wait)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	cases := []struct {
+		Value string
+		Min   time.Duration
+		Max   time.Duration
+		OK    bool
+	}{
+		{"", 0, 0, false},
+		{"abc", 0, 0, false},
+		{"-1", 0, 0, false},
+		{"0", 0, 0, true},
+		{"7", 7 * time.Second, 7 * time.Second, true},
+		{time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat), 28 * time.Second, 30 * time.Second, true},
+		{time.Now().Add(-30 * time.Second).UTC().Format(http.TimeFormat), 0, 0, true},
+	}
+
+	for _, tc := range cases {
+		wait, ok := parseRetryAfter(tc.Value)
+		if ok != tc.OK {
+			t.Errorf("%q: expected ok to be %t", tc.Value, tc.OK)
+			continue
+		}
+		if wait < tc.Min || wait > tc.Max {
+			t.Errorf("%q: expected a wait between %s and %s, got %s", tc.Value, tc.Min, tc.Max, wait)
+		}
+	}
+}
@@ -13,6 +13,19 @@ func flattenStringList(list []*string) []interface{} {
 	return vs
 }
 
+// Takes the result of flatmap.Expand for an array of strings
+// and returns a []*string
+func expandStringList(configured []interface{}) []*string {
+	vs := make([]*string, 0, len(configured))
+	for _, v := range configured {
+		val, ok := v.(string)
+		if ok && val != "" {
+			vs = append(vs, &val)
+		}
+	}
+	return vs
+}
+
 func pointersMapToStringList(pointers map[string]*string) map[string]interface{} {
 	list := make(map[string]interface{}, len(pointers))
 	for i, v := range pointers {
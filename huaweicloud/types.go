@@ -17,6 +17,10 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/configurations"
+	"github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/groups"
+	aspolicies "github.com/huaweicloud/golangsdk/openstack/autoscaling/v1/policies"
 	"github.com/huaweicloud/golangsdk/openstack/dns/v2/recordsets"
 	"github.com/huaweicloud/golangsdk/openstack/dns/v2/zones"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v1/eips"
@@ -138,6 +142,20 @@ func (lrt *LogRoundTripper) formatJSON(raw []byte) string {
 		}
 	}
 
+	// Mask the temporary credentials returned when assuming an agency
+	if v, ok := data["credential"].(map[string]interface{}); ok {
+		for _, k := range []string{"secret", "securitytoken"} {
+			if _, ok := v[k]; ok {
+				v[k] = "***"
+			}
+		}
+	}
+
+	// Mask the data encrypted and decrypted by KMS
+	if _, ok := data["plain_text"]; ok {
+		data["plain_text"] = "***"
+	}
+
 	// Ignore the catalog
 	if v, ok := data["token"].(map[string]interface{}); ok {
 		if _, ok := v["catalog"]; ok {
@@ -355,6 +373,157 @@ func (opts ZoneCreateOpts) ToZoneCreateMap() (map[string]interface{}, error) {
 
 // EIPCreateOpts represents the attributes used when creating a new eip.
 type EIPCreateOpts struct {
-	eips.ApplyOpts
+	EIPApplyOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
+
+// EIPApplyOpts represents the attributes used when applying for an eip. It
+// replaces the bandwidth of eips.ApplyOpts so that a shared bandwidth can be
+// given by its id alone.
+type EIPApplyOpts struct {
+	IP        eips.PublicIpOpts `json:"publicip" required:"true"`
+	Bandwidth EIPBandwidthOpts  `json:"bandwidth" required:"true"`
+}
+
+// EIPBandwidthOpts represents the bandwidth of an eip. A dedicated (PER)
+// bandwidth needs a name and a size, a shared (WHOLE) one its id.
+type EIPBandwidthOpts struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Size       int    `json:"size,omitempty"`
+	ShareType  string `json:"share_type" required:"true"`
+	ChargeMode string `json:"charge_mode,omitempty"`
+}
+
+// ToPublicIpApplyMap casts an EIPApplyOpts struct to a map.
+func (opts EIPApplyOpts) ToPublicIpApplyMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ASGroupUpdateOpts represents the attributes used when updating an AS group.
+// It overrides the instance numbers and delete_publicip of groups.UpdateOpts
+// so that they can be set to 0 and false.
+type ASGroupUpdateOpts struct {
+	groups.UpdateOpts
+	MinInstanceNumber    int  `json:"min_instance_number"`
+	DesireInstanceNumber int  `json:"desire_instance_number"`
+	IsDeletePublicip     bool `json:"delete_publicip"`
+}
+
+// ToGroupUpdateMap casts an ASGroupUpdateOpts struct to a map.
+func (opts ASGroupUpdateOpts) ToGroupUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ASConfigurationCreateOpts represents the attributes used when creating an
+// AS configuration. Its disks override InstanceConfig.Disk so that they can
+// carry the metadata of the encrypted disks.
+type ASConfigurationCreateOpts struct {
+	configurations.CreateOpts
+	Disk []ASConfigurationDiskOpts `json:"-"`
+}
+
+// ASConfigurationDiskOpts represents a disk of an AS configuration. It adds
+// the metadata to configurations.DiskOpts.
+type ASConfigurationDiskOpts struct {
+	configurations.DiskOpts
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ToConfigurationCreateMap casts an ASConfigurationCreateOpts struct to a map.
+func (opts ASConfigurationCreateOpts) ToConfigurationCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToConfigurationCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.Disk) > 0 {
+		disks := make([]interface{}, len(opts.Disk))
+		for i, disk := range opts.Disk {
+			disks[i], err = golangsdk.BuildRequestBody(disk, "")
+			if err != nil {
+				return nil, err
+			}
+		}
+		b["instance_config"].(map[string]interface{})["disk"] = disks
+	}
+
+	return b, nil
+}
+
+// ASConfigurationDisk represents a disk of an AS configuration together with
+// its metadata, which configurations.Disk leaves out.
+type ASConfigurationDisk struct {
+	configurations.Disk
+	Metadata map[string]string `json:"metadata"`
+}
+
+// extractASConfigurationDisks interprets the disks of a configurations.Get
+// result as ASConfigurationDisks.
+func extractASConfigurationDisks(r configurations.GetResult) ([]ASConfigurationDisk, error) {
+	var s struct {
+		InstanceConfig struct {
+			Disk []ASConfigurationDisk `json:"disk"`
+		} `json:"instance_config"`
+	}
+	err := r.ExtractIntoStructPtr(&s, "scaling_configuration")
+	return s.InstanceConfig.Disk, err
+}
+
+// ASInstancesListOpts represents the attributes used when listing the
+// instances of an AS group. It adds the paging parameters which are missing
+// from instances.ListOpts.
+type ASInstancesListOpts struct {
+	LifeCycleStatus string `q:"life_cycle_state"`
+	HealthStatus    string `q:"health_status"`
+	StartNumber     int    `q:"start_number"`
+	Limit           int    `q:"limit"`
+}
+
+// ToInstancesListQuery formats an ASInstancesListOpts into a query string.
+func (opts ASInstancesListOpts) ToInstancesListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ASPolicyCreateOpts represents the attributes used when creating an AS policy.
+type ASPolicyCreateOpts struct {
+	aspolicies.CreateOpts
+}
+
+// ToPolicyCreateMap casts an ASPolicyCreateOpts struct to a map.
+// It overrides aspolicies.ToPolicyCreateMap to drop the empty scheduled_policy
+// of an ALARM policy.
+func (opts ASPolicyCreateOpts) ToPolicyCreateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts.CreateOpts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Type == "ALARM" {
+		delete(b, "scheduled_policy")
+	}
+
+	return b, nil
+}
+
+// ASPolicyUpdateOpts represents the attributes used when updating an AS policy.
+type ASPolicyUpdateOpts struct {
+	aspolicies.UpdateOpts
+}
+
+// ToPolicyUpdateMap casts an ASPolicyUpdateOpts struct to a map.
+// It overrides aspolicies.ToPolicyUpdateMap to drop the empty scheduled_policy
+// of an ALARM policy.
+func (opts ASPolicyUpdateOpts) ToPolicyUpdateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts.UpdateOpts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Type == "ALARM" {
+		delete(b, "scheduled_policy")
+	}
+
+	return b, nil
+}
@@ -86,7 +86,7 @@ func MapResourceProp(d *schema.ResourceData, prop string) map[string]interface{}
 var REDACT_HEADERS = []string{"x-auth-token", "x-auth-key", "x-service-token",
 	"x-storage-token", "x-account-meta-temp-url-key", "x-account-meta-temp-url-key-2",
 	"x-container-meta-temp-url-key", "x-container-meta-temp-url-key-2", "set-cookie",
-	"x-subject-token"}
+	"x-subject-token", "x-security-token", "x-obs-security-token", "authorization"}
 
 // RedactHeaders processes a headers object, returning a redacted list
 func RedactHeaders(headers http.Header) (processedHeaders []string) {
@@ -2,6 +2,8 @@ package huaweicloud
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"time"
 )
 
@@ -56,6 +58,33 @@ func validateS3BucketLifecycleRuleId(v interface{}, k string) (ws []string, erro
 	return
 }
 
+func validateS3BucketReplicationRuleId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 255 characters", k))
+	}
+	return
+}
+
+func validateS3BucketReplicationRulePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 1024 characters", k))
+	}
+	return
+}
+
+func validateS3BucketObjectsMaxKeys(v interface{}, k string) (ws []string, errors []error) {
+	if v.(int) < 1 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be at least 1, got %d", k, v.(int)))
+	}
+
+	return
+}
+
 func validateJsonString(v interface{}, k string) (ws []string, errors []error) {
 	if _, err := normalizeJsonString(v); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
@@ -72,3 +101,55 @@ func validateKmsKeyStatus(v interface{}, k string) (ws []string, errors []error)
 	}
 	return
 }
+
+func validateKmsKeyPendingDays(v interface{}, k string) (ws []string, errors []error) {
+	days, err := strconv.Atoi(v.(string))
+	if err != nil || days < 7 || days > 1096 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a number of days between 7 and 1096, got %s.", k, v.(string)))
+	}
+	return
+}
+
+func validateKmsKeyRotationInterval(v interface{}, k string) (ws []string, errors []error) {
+	interval := v.(int)
+	if interval < 30 || interval > 365 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a number of days between 30 and 365, got %d.", k, interval))
+	}
+	return
+}
+
+func validateMaxRetries(v interface{}, k string) (ws []string, errors []error) {
+	if v.(int) < 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be at least 0, got %d", k, v.(int)))
+	}
+
+	return
+}
+
+func validateRetryMaxWait(v interface{}, k string) (ws []string, errors []error) {
+	if v.(int) < 1 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be at least 1 second, got %d", k, v.(int)))
+	}
+
+	return
+}
+
+func validateRegexp(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a valid regular expression: %s", k, err))
+	}
+	return
+}
+
+func validateRFC3339Timestamp(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be parsed as RFC3339 Timestamp Format: %s", k, err))
+	}
+	return
+}
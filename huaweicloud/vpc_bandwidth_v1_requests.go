@@ -0,0 +1,138 @@
+package huaweicloud
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v1/bandwidths"
+)
+
+// The shared bandwidth requests below are missing from the vendored
+// golangsdk networking/v1/bandwidths package.
+
+const bandwidthResourcePath = "bandwidths"
+
+// Bandwidth represents a bandwidth together with the public ips using it,
+// which bandwidths.BandWidth leaves out.
+type Bandwidth struct {
+	bandwidths.BandWidth
+	PublicIPInfo []BandwidthPublicIP `json:"publicip_info"`
+}
+
+// BandwidthPublicIP represents a public ip using a bandwidth.
+type BandwidthPublicIP struct {
+	PublicIPID      string `json:"publicip_id" required:"true"`
+	PublicIPAddress string `json:"publicip_address,omitempty"`
+	PublicIPType    string `json:"publicip_type,omitempty"`
+}
+
+type bandwidthResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a bandwidthResult as a Bandwidth.
+func (r bandwidthResult) Extract() (*Bandwidth, error) {
+	var bw Bandwidth
+	err := r.ExtractIntoStructPtr(&bw, "bandwidth")
+	return &bw, err
+}
+
+// BandwidthCreateOpts represents the attributes used when creating a shared
+// bandwidth.
+type BandwidthCreateOpts struct {
+	Name string `json:"name" required:"true"`
+	Size int    `json:"size" required:"true"`
+}
+
+// ToBandwidthCreateMap casts a BandwidthCreateOpts struct to a map.
+func (opts BandwidthCreateOpts) ToBandwidthCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "bandwidth")
+}
+
+// BandwidthUpdateOpts represents the attributes used when updating a
+// bandwidth. It adds the charge mode to bandwidths.UpdateOpts.
+type BandwidthUpdateOpts struct {
+	Size       int    `json:"size,omitempty"`
+	Name       string `json:"name,omitempty"`
+	ChargeMode string `json:"charge_mode,omitempty"`
+}
+
+// ToBWUpdateMap casts a BandwidthUpdateOpts struct to a map.
+func (opts BandwidthUpdateOpts) ToBWUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "bandwidth")
+}
+
+// BandwidthInsertOpts represents the public ips added to a shared bandwidth.
+type BandwidthInsertOpts struct {
+	PublicIPInfo []BandwidthPublicIP `json:"publicip_info" required:"true"`
+}
+
+// ToBandwidthInsertMap casts a BandwidthInsertOpts struct to a map.
+func (opts BandwidthInsertOpts) ToBandwidthInsertMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "bandwidth")
+}
+
+// BandwidthRemoveOpts represents the public ips removed from a shared
+// bandwidth. They get a dedicated bandwidth of the given size and charge mode.
+type BandwidthRemoveOpts struct {
+	PublicIPInfo []BandwidthPublicIP `json:"publicip_info" required:"true"`
+	ChargeMode   string              `json:"charge_mode" required:"true"`
+	Size         int                 `json:"size" required:"true"`
+}
+
+// ToBandwidthRemoveMap casts a BandwidthRemoveOpts struct to a map.
+func (opts BandwidthRemoveOpts) ToBandwidthRemoveMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "bandwidth")
+}
+
+// createBandwidth creates a shared bandwidth. It is only available with the
+// networking v2 client.
+func createBandwidth(client *golangsdk.ServiceClient, opts BandwidthCreateOpts) (r bandwidthResult) {
+	b, err := opts.ToBandwidthCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, bandwidthResourcePath), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func getBandwidth(client *golangsdk.ServiceClient, id string) (r bandwidthResult) {
+	_, r.Err = client.Get(client.ServiceURL(client.ProjectID, bandwidthResourcePath, id), &r.Body, nil)
+	return
+}
+
+// deleteBandwidth deletes a shared bandwidth. It is only available with the
+// networking v2 client.
+func deleteBandwidth(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL(client.ProjectID, bandwidthResourcePath, id), nil)
+	return
+}
+
+// insertBandwidthPublicIPs adds public ips to a shared bandwidth. It is only
+// available with the networking v2 client.
+func insertBandwidthPublicIPs(client *golangsdk.ServiceClient, id string, opts BandwidthInsertOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToBandwidthInsertMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, bandwidthResourcePath, id, "insert"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// removeBandwidthPublicIPs removes public ips from a shared bandwidth. It is
+// only available with the networking v2 client.
+func removeBandwidthPublicIPs(client *golangsdk.ServiceClient, id string, opts BandwidthRemoveOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToBandwidthRemoveMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, bandwidthResourcePath, id, "remove"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,199 @@
+package huaweicloud
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// The VPC subnet requests are missing from the vendored golangsdk
+// networking/v1 packages.
+
+const vpcSubnetResourcePath = "subnets"
+
+// VpcSubnet represents a subnet of a VPC.
+type VpcSubnet struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	CIDR             string   `json:"cidr"`
+	GatewayIP        string   `json:"gateway_ip"`
+	DhcpEnable       bool     `json:"dhcp_enable"`
+	PrimaryDNS       string   `json:"primary_dns"`
+	SecondaryDNS     string   `json:"secondary_dns"`
+	DNSList          []string `json:"dnsList"`
+	AvailabilityZone string   `json:"availability_zone"`
+	VpcID            string   `json:"vpc_id"`
+	Status           string   `json:"status"`
+	NeutronSubnetID  string   `json:"neutron_subnet_id"`
+}
+
+type vpcSubnetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a vpcSubnetResult as a VpcSubnet.
+func (r vpcSubnetResult) Extract() (*VpcSubnet, error) {
+	var subnet VpcSubnet
+	err := r.ExtractIntoStructPtr(&subnet, "subnet")
+	return &subnet, err
+}
+
+// VpcSubnetPage is a single page of VPC subnets.
+type VpcSubnetPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether a VpcSubnetPage has no subnet.
+func (r VpcSubnetPage) IsEmpty() (bool, error) {
+	subnets, err := extractVpcSubnets(r)
+	return len(subnets) == 0, err
+}
+
+// LastMarker returns the id of the last subnet of a VpcSubnetPage.
+func (r VpcSubnetPage) LastMarker() (string, error) {
+	subnets, err := extractVpcSubnets(r)
+	if err != nil || len(subnets) == 0 {
+		return "", err
+	}
+	return subnets[len(subnets)-1].ID, nil
+}
+
+func extractVpcSubnets(r pagination.Page) ([]VpcSubnet, error) {
+	var s struct {
+		Subnets []VpcSubnet `json:"subnets"`
+	}
+	err := (r.(VpcSubnetPage)).ExtractInto(&s)
+	return s.Subnets, err
+}
+
+// VpcSubnetListOpts represents the attributes used when listing VPC subnets.
+// VpcID and Limit are sent to the API, the other fields are matched on the
+// results by filterVpcSubnets.
+type VpcSubnetListOpts struct {
+	VpcID            string `q:"vpc_id"`
+	Limit            int    `q:"limit"`
+	ID               string `q:"-"`
+	Name             string `q:"-"`
+	CIDR             string `q:"-"`
+	GatewayIP        string `q:"-"`
+	AvailabilityZone string `q:"-"`
+	Status           string `q:"-"`
+}
+
+// ToSubnetListQuery formats a VpcSubnetListOpts into a query string.
+func (opts VpcSubnetListOpts) ToSubnetListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// VpcSubnetCreateOpts represents the attributes used when creating a VPC
+// subnet.
+type VpcSubnetCreateOpts struct {
+	Name             string   `json:"name" required:"true"`
+	CIDR             string   `json:"cidr" required:"true"`
+	GatewayIP        string   `json:"gateway_ip" required:"true"`
+	VpcID            string   `json:"vpc_id" required:"true"`
+	DhcpEnable       *bool    `json:"dhcp_enable,omitempty"`
+	PrimaryDNS       string   `json:"primary_dns,omitempty"`
+	SecondaryDNS     string   `json:"secondary_dns,omitempty"`
+	DNSList          []string `json:"dnsList,omitempty"`
+	AvailabilityZone string   `json:"availability_zone,omitempty"`
+}
+
+// ToSubnetCreateMap casts a VpcSubnetCreateOpts struct to a map.
+func (opts VpcSubnetCreateOpts) ToSubnetCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+// VpcSubnetUpdateOpts represents the attributes used when updating a VPC
+// subnet.
+type VpcSubnetUpdateOpts struct {
+	Name         string   `json:"name" required:"true"`
+	DhcpEnable   *bool    `json:"dhcp_enable,omitempty"`
+	PrimaryDNS   string   `json:"primary_dns,omitempty"`
+	SecondaryDNS string   `json:"secondary_dns,omitempty"`
+	DNSList      []string `json:"dnsList,omitempty"`
+}
+
+// ToSubnetUpdateMap casts a VpcSubnetUpdateOpts struct to a map.
+func (opts VpcSubnetUpdateOpts) ToSubnetUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+func listVpcSubnets(client *golangsdk.ServiceClient, opts VpcSubnetListOpts) pagination.Pager {
+	url := client.ServiceURL(client.ProjectID, vpcSubnetResourcePath)
+	query, err := opts.ToSubnetListQuery()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	url += query
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := VpcSubnetPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// filterVpcSubnets returns the subnets matching the fields of opts which are
+// not sent to the API.
+func filterVpcSubnets(subnets []VpcSubnet, opts VpcSubnetListOpts) []VpcSubnet {
+	var matched []VpcSubnet
+	for _, subnet := range subnets {
+		if opts.ID != "" && subnet.ID != opts.ID {
+			continue
+		}
+		if opts.Name != "" && subnet.Name != opts.Name {
+			continue
+		}
+		if opts.CIDR != "" && subnet.CIDR != opts.CIDR {
+			continue
+		}
+		if opts.GatewayIP != "" && subnet.GatewayIP != opts.GatewayIP {
+			continue
+		}
+		if opts.AvailabilityZone != "" && subnet.AvailabilityZone != opts.AvailabilityZone {
+			continue
+		}
+		if opts.Status != "" && subnet.Status != opts.Status {
+			continue
+		}
+		matched = append(matched, subnet)
+	}
+	return matched
+}
+
+func createVpcSubnet(client *golangsdk.ServiceClient, opts VpcSubnetCreateOpts) (r vpcSubnetResult) {
+	b, err := opts.ToSubnetCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, vpcSubnetResourcePath), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func getVpcSubnet(client *golangsdk.ServiceClient, id string) (r vpcSubnetResult) {
+	_, r.Err = client.Get(client.ServiceURL(client.ProjectID, vpcSubnetResourcePath, id), &r.Body, nil)
+	return
+}
+
+// updateVpcSubnet updates a subnet of the VPC vpcID.
+func updateVpcSubnet(client *golangsdk.ServiceClient, vpcID, id string, opts VpcSubnetUpdateOpts) (r vpcSubnetResult) {
+	b, err := opts.ToSubnetUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL(client.ProjectID, vpcResourcePath, vpcID, vpcSubnetResourcePath, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// deleteVpcSubnet deletes a subnet of the VPC vpcID.
+func deleteVpcSubnet(client *golangsdk.ServiceClient, vpcID, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL(client.ProjectID, vpcResourcePath, vpcID, vpcSubnetResourcePath, id), nil)
+	return
+}
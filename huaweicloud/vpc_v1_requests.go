@@ -0,0 +1,171 @@
+package huaweicloud
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// The VPC requests are missing from the vendored golangsdk networking/v1
+// packages.
+
+const vpcResourcePath = "vpcs"
+
+// Vpc represents a VPC.
+type Vpc struct {
+	ID     string     `json:"id"`
+	Name   string     `json:"name"`
+	CIDR   string     `json:"cidr"`
+	Status string     `json:"status"`
+	Routes []VpcRoute `json:"routes"`
+}
+
+// VpcRoute represents a route of a VPC.
+type VpcRoute struct {
+	DestinationCIDR string `json:"destination"`
+	NextHop         string `json:"nexthop"`
+}
+
+type vpcResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a vpcResult as a Vpc.
+func (r vpcResult) Extract() (*Vpc, error) {
+	var vpc Vpc
+	err := r.ExtractIntoStructPtr(&vpc, "vpc")
+	return &vpc, err
+}
+
+// VpcPage is a single page of VPCs.
+type VpcPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether a VpcPage has no VPC.
+func (r VpcPage) IsEmpty() (bool, error) {
+	vpcs, err := extractVpcs(r)
+	return len(vpcs) == 0, err
+}
+
+// LastMarker returns the id of the last VPC of a VpcPage.
+func (r VpcPage) LastMarker() (string, error) {
+	vpcs, err := extractVpcs(r)
+	if err != nil || len(vpcs) == 0 {
+		return "", err
+	}
+	return vpcs[len(vpcs)-1].ID, nil
+}
+
+func extractVpcs(r pagination.Page) ([]Vpc, error) {
+	var s struct {
+		Vpcs []Vpc `json:"vpcs"`
+	}
+	err := (r.(VpcPage)).ExtractInto(&s)
+	return s.Vpcs, err
+}
+
+// VpcListOpts represents the attributes used when listing VPCs. ID and Limit
+// are sent to the API, the other fields are matched on the results by
+// filterVpcs.
+type VpcListOpts struct {
+	ID     string `q:"id"`
+	Limit  int    `q:"limit"`
+	Name   string `q:"-"`
+	CIDR   string `q:"-"`
+	Status string `q:"-"`
+}
+
+// ToVpcListQuery formats a VpcListOpts into a query string.
+func (opts VpcListOpts) ToVpcListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// VpcCreateOpts represents the attributes used when creating a VPC.
+type VpcCreateOpts struct {
+	Name string `json:"name,omitempty"`
+	CIDR string `json:"cidr,omitempty"`
+}
+
+// ToVpcCreateMap casts a VpcCreateOpts struct to a map.
+func (opts VpcCreateOpts) ToVpcCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "vpc")
+}
+
+// VpcUpdateOpts represents the attributes used when updating a VPC.
+type VpcUpdateOpts struct {
+	Name string `json:"name,omitempty"`
+	CIDR string `json:"cidr,omitempty"`
+}
+
+// ToVpcUpdateMap casts a VpcUpdateOpts struct to a map.
+func (opts VpcUpdateOpts) ToVpcUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "vpc")
+}
+
+func listVpcs(client *golangsdk.ServiceClient, opts VpcListOpts) pagination.Pager {
+	url := client.ServiceURL(client.ProjectID, vpcResourcePath)
+	query, err := opts.ToVpcListQuery()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	url += query
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := VpcPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// filterVpcs returns the VPCs matching the Name, CIDR and Status of opts.
+func filterVpcs(vpcs []Vpc, opts VpcListOpts) []Vpc {
+	var matched []Vpc
+	for _, vpc := range vpcs {
+		if opts.Name != "" && vpc.Name != opts.Name {
+			continue
+		}
+		if opts.CIDR != "" && vpc.CIDR != opts.CIDR {
+			continue
+		}
+		if opts.Status != "" && vpc.Status != opts.Status {
+			continue
+		}
+		matched = append(matched, vpc)
+	}
+	return matched
+}
+
+func createVpc(client *golangsdk.ServiceClient, opts VpcCreateOpts) (r vpcResult) {
+	b, err := opts.ToVpcCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, vpcResourcePath), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func getVpc(client *golangsdk.ServiceClient, id string) (r vpcResult) {
+	_, r.Err = client.Get(client.ServiceURL(client.ProjectID, vpcResourcePath, id), &r.Body, nil)
+	return
+}
+
+func updateVpc(client *golangsdk.ServiceClient, id string, opts VpcUpdateOpts) (r vpcResult) {
+	b, err := opts.ToVpcUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL(client.ProjectID, vpcResourcePath, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func deleteVpc(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL(client.ProjectID, vpcResourcePath, id), nil)
+	return
+}